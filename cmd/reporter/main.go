@@ -1,14 +1,37 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/carvalhocaio/routines-in-the-night/internal/config"
-	"github.com/carvalhocaio/routines-in-the-night/internal/discord"
 	"github.com/carvalhocaio/routines-in-the-night/internal/gemini"
 	"github.com/carvalhocaio/routines-in-the-night/internal/github"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/discord"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/email"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/gotify"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/matrix"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/mattermost"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/slack"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/telegram"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify/webhook"
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source/bitbucket"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source/gitlab"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source/jira"
+	"github.com/carvalhocaio/routines-in-the-night/internal/store"
+	"github.com/carvalhocaio/routines-in-the-night/internal/store/jsonstore"
+	"github.com/carvalhocaio/routines-in-the-night/internal/store/sqlitestore"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer/anthropic"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer/ollama"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer/openai"
 )
 
 func main() {
@@ -19,17 +42,27 @@ func main() {
 	}
 
 	// Initialize services
-	githubClient := github.NewClient(cfg.GitHubUser, cfg.GitHubToken)
-	geminiClient := gemini.NewClient(cfg.GeminiAPIKey, cfg.GeminiModel)
-	discordClient := discord.NewClient(cfg.DiscordWebhookURL)
+	st, err := buildStore(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize store: %v", err)
+	}
+	sources, err := buildSources(cfg, st)
+	if err != nil {
+		log.Fatalf("Failed to initialize sources: %v", err)
+	}
+	summarizerClient, err := buildSummarizer(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize summarizer: %v", err)
+	}
+	notifier := buildNotifier(cfg)
 
 	// Execute the daily report workflow
-	if err := run(githubClient, geminiClient, discordClient); err != nil {
+	if err := run(sources, summarizerClient, notifier, st); err != nil {
 		log.Printf("Error running daily report: %v", err)
 
-		// Try to send error to Discord
-		if sendErr := discordClient.SendError(err); sendErr != nil {
-			log.Printf("Failed to send error to Discord: %v", sendErr)
+		// Try to send the error to every configured notifier
+		if sendErr := notifier.SendError(context.Background(), err); sendErr != nil {
+			log.Printf("Failed to send error notification: %v", sendErr)
 		}
 
 		os.Exit(1)
@@ -38,42 +71,235 @@ func main() {
 	log.Println("Daily report completed successfully!")
 }
 
+// buildStore constructs the configured event history persistence layer,
+// returning a nil Store (and no error) when STORE_BACKEND is unset, which
+// leaves sources fetching a fixed 24h window with no dedup.
+func buildStore(cfg *config.Config) (store.Store, error) {
+	switch cfg.StoreBackend {
+	case "":
+		return nil, nil
+	case "sqlite":
+		return sqlitestore.New(cfg.StorePath)
+	case "json":
+		return jsonstore.New(cfg.StorePath), nil
+	default:
+		return nil, fmt.Errorf("unknown STORE_BACKEND: %s", cfg.StoreBackend)
+	}
+}
+
+// buildSources constructs the ordered list of enabled activity sources
+// from configuration. st, if non-nil, is wired into the GitHub source so
+// it can window its fetch off the last successful run and persist history.
+func buildSources(cfg *config.Config, st store.Store) ([]source.Source, error) {
+	githubSource, err := newGitHubSource(cfg, st)
+	if err != nil {
+		return nil, err
+	}
+
+	available := map[string]source.Source{
+		"github": githubSource,
+	}
+
+	if cfg.GitLabUser != "" {
+		available["gitlab"] = gitlab.NewClient(cfg.GitLabUser, cfg.GitLabToken)
+	}
+	if cfg.BitbucketUser != "" {
+		available["bitbucket"] = bitbucket.NewClient(cfg.BitbucketUser, cfg.BitbucketAppPassword)
+	}
+	if cfg.JiraBaseURL != "" {
+		available["jira"] = jira.NewClient(cfg.JiraBaseURL, cfg.JiraEmail, cfg.JiraAPIToken)
+	}
+
+	sources := make([]source.Source, 0, len(cfg.EnabledSources))
+	for _, name := range cfg.EnabledSources {
+		src, ok := available[name]
+		if !ok {
+			log.Printf("Skipping unknown or unconfigured source: %s", name)
+			continue
+		}
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
+
+// newGitHubSource picks between a personal-access-token client and a
+// GitHub App installation client, preferring the app credentials when the
+// full trio is configured. st, if non-nil, is wired in via SetStore.
+func newGitHubSource(cfg *config.Config, st store.Store) (source.Source, error) {
+	var client *github.Client
+
+	if cfg.GitHubAppID != 0 && cfg.GitHubAppInstallationID != 0 && len(cfg.GitHubAppPrivateKey) != 0 {
+		appClient, err := github.NewAppClient(
+			cfg.GitHubUser, cfg.GitHubAppID, cfg.GitHubAppInstallationID, cfg.GitHubAppPrivateKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		client = appClient
+	} else {
+		client = github.NewClient(cfg.GitHubUser, cfg.GitHubToken)
+	}
+
+	if st != nil {
+		client.SetStore(st)
+	}
+
+	return client, nil
+}
+
+// buildSummarizer selects the configured LLM backend to generate the
+// daily summary, defaulting to Gemini. The "openai" backend doubles as a
+// generic OpenAI-compatible client: pointing OpenAIBaseURL at a self-hosted
+// server (LocalAI, LM Studio, vLLM, ...) runs the reporter fully offline.
+func buildSummarizer(cfg *config.Config) (summarizer.Summarizer, error) {
+	summarizerCfg := summarizer.Config{
+		Model:           cfg.SummarizerModel,
+		Temperature:     cfg.SummarizerTemperature,
+		MaxOutputTokens: cfg.SummarizerMaxTokens,
+	}
+
+	switch cfg.Summarizer {
+	case "openai":
+		if cfg.OpenAIBaseURL != "" {
+			return openai.NewClientWithBaseURL(cfg.OpenAIAPIKey, summarizerCfg, cfg.OpenAIBaseURL), nil
+		}
+		return openai.NewClient(cfg.OpenAIAPIKey, summarizerCfg), nil
+	case "anthropic":
+		return anthropic.NewClient(cfg.AnthropicAPIKey, summarizerCfg), nil
+	case "ollama":
+		return ollama.NewClient(cfg.OllamaBaseURL, summarizerCfg), nil
+	default:
+		client := gemini.NewClient(cfg.GeminiAPIKey, cfg.GeminiModel)
+		client.SetUser(cfg.GitHubUser)
+		client.SetLocale(cfg.PromptLocale)
+		client.SetPersona(cfg.PromptPersona)
+		if cfg.PromptTemplate != "" {
+			client.WithPrompt(cfg.PromptTemplate)
+		}
+		return client, nil
+	}
+}
+
+// buildNotifier constructs a fan-out notifier over every enabled
+// notification backend from configuration.
+func buildNotifier(cfg *config.Config) *notify.Multi {
+	available := map[string]notify.Notifier{
+		"discord": discord.New(cfg.DiscordWebhookURL),
+	}
+
+	if cfg.SlackWebhookURL != "" {
+		available["slack"] = slack.New(cfg.SlackWebhookURL)
+	}
+	if cfg.TelegramBotToken != "" {
+		available["telegram"] = telegram.New(cfg.TelegramBotToken, cfg.TelegramChatID)
+	}
+	if cfg.MattermostWebhookURL != "" {
+		available["mattermost"] = mattermost.New(cfg.MattermostWebhookURL)
+	}
+	if cfg.MatrixBaseURL != "" {
+		available["matrix"] = matrix.New(cfg.MatrixBaseURL, cfg.MatrixRoomID, cfg.MatrixAccessToken)
+	}
+	if cfg.WebhookURL != "" {
+		available["webhook"] = webhook.New(cfg.WebhookURL)
+	}
+	if cfg.GotifyBaseURL != "" {
+		available["gotify"] = gotify.New(cfg.GotifyBaseURL, cfg.GotifyToken)
+	}
+	if cfg.SMTPHost != "" {
+		available["email"] = email.New(
+			cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, cfg.SMTPTo,
+		)
+	}
+
+	notifiers := make([]notify.Notifier, 0, len(cfg.EnabledNotifiers))
+	for _, name := range cfg.EnabledNotifiers {
+		n, ok := available[name]
+		if !ok {
+			log.Printf("Skipping unknown or unconfigured notifier: %s", name)
+			continue
+		}
+		notifiers = append(notifiers, n)
+	}
+
+	return notify.NewMulti(notifiers...)
+}
+
 func run(
-	githubClient *github.Client,
-	geminiClient *gemini.Client,
-	discordClient *discord.Client,
+	sources []source.Source,
+	summarizerClient summarizer.Summarizer,
+	notifier *notify.Multi,
+	st store.Store,
 ) error {
-	// Fetch GitHub events from last 24 hours
-	log.Println("Fetching GitHub events...")
-	events, err := githubClient.GetDailyEvents()
+	ctx := context.Background()
+	now := time.Now()
+	from := now.Add(-24 * time.Hour)
+	var errs []error
+
+	// Fetch events from every enabled source in parallel. A partial
+	// failure here doesn't stop the pipeline: the sources that did
+	// respond still produce a useful summary.
+	log.Printf("Fetching events from %d source(s)...", len(sources))
+	events, err := source.Merge(ctx, sources)
 	if err != nil {
-		return fmt.Errorf("failed to fetch GitHub events: %w", err)
+		log.Printf("Some sources failed: %v", err)
+		errs = append(errs, fmt.Errorf("failed to fetch events: %w", err))
 	}
 
 	log.Printf("Found %d events in the last 24 hours", len(events))
 
 	if len(events) == 0 {
 		log.Println("No events found, sending default message")
-		return discordClient.SendDailyReport(
-			"Hoje foi um dia de planejamento e reflexão no código.",
-		)
+		if sendErr := notifier.Send(ctx, notify.Report{
+			Summary: "Hoje foi um dia de planejamento e reflexão no código.",
+		}); sendErr != nil {
+			errs = append(errs, fmt.Errorf("failed to send notifications: %w", sendErr))
+		} else {
+			markRun(st, &errs)
+		}
+		return errors.Join(errs...)
 	}
 
-	// Generate summary using Gemini
-	log.Println("Generating summary with Gemini AI...")
-	summary, err := geminiClient.GenerateDailySummary(events)
+	// Generate summary using the configured summarizer backend
+	log.Println("Generating summary...")
+	summary, err := summarizerClient.Summarize(ctx, events)
 	if err != nil {
-		return fmt.Errorf("failed to generate summary: %w", err)
+		return errors.Join(append(errs, fmt.Errorf("failed to generate summary: %w", err))...)
 	}
 
 	log.Printf("Generated summary (%d characters)", len(summary))
 
-	// Send to Discord
-	log.Println("Sending report to Discord...")
-	if err := discordClient.SendDailyReport(summary); err != nil {
-		return fmt.Errorf("failed to send to Discord: %w", err)
+	dailyReport := report.NewDailyReport(events, from, now)
+	dailyReport.Summary = summary
+
+	// Send to every configured notifier. notifier.Send already fans out
+	// and joins per-backend failures, so one notifier going down doesn't
+	// keep the others from delivering the report.
+	log.Println("Sending report to configured notifiers...")
+	if err := notifier.Send(ctx, notify.Report{Summary: summary, Structured: &dailyReport}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to send notifications: %w", err))
+	} else {
+		markRun(st, &errs)
+	}
+
+	if len(errs) == 0 {
+		log.Println("Report sent successfully!")
+	}
+
+	return errors.Join(errs...)
+}
+
+// markRun records a successful run so the next fetch windows off this
+// point instead of a fixed 24h lookback. It is only called after the
+// notification has succeeded: if the process crashes or notify fails
+// first, the next run re-fetches (and re-sends) the same window instead
+// of silently skipping events that were never actually reported.
+func markRun(st store.Store, errs *[]error) {
+	if st == nil {
+		return
 	}
 
-	log.Println("Report sent successfully!")
-	return nil
+	if err := st.MarkRun(time.Now()); err != nil {
+		*errs = append(*errs, fmt.Errorf("failed to mark run: %w", err))
+	}
 }
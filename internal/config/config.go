@@ -3,21 +3,111 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"text/template"
 
 	"github.com/joho/godotenv"
 )
 
 const (
-	defaultGeminiModel = "gemini-2.5-flash"
+	defaultGeminiModel        = "gemini-2.5-flash"
+	defaultEnabledSource      = "github"
+	defaultEnabledNotifier    = "discord"
+	defaultSummarizer         = "gemini"
+	defaultSummarizerTemp     = 1.2
+	defaultSummarizerMaxToken = 8192
 )
 
 // Config holds all application configuration
 type Config struct {
-	GitHubUser        string
-	GitHubToken       string
+	GitHubUser  string
+	GitHubToken string
+
+	// GitHubAppID, GitHubAppInstallationID, and GitHubAppPrivateKey are an
+	// alternative to GitHubToken: when set, the reporter authenticates as a
+	// GitHub App installation instead of a personal access token.
+	GitHubAppID             int64
+	GitHubAppInstallationID int64
+	GitHubAppPrivateKey     []byte
+
 	GeminiAPIKey      string
 	GeminiModel       string
 	DiscordWebhookURL string
+
+	// EnabledSources lists, in order, which activity sources to query
+	// (e.g. "github", "gitlab", "bitbucket", "jira").
+	EnabledSources []string
+
+	GitLabUser  string
+	GitLabToken string
+
+	BitbucketUser        string
+	BitbucketAppPassword string
+
+	JiraBaseURL  string
+	JiraEmail    string
+	JiraAPIToken string
+
+	// EnabledNotifiers lists which notification backends to dispatch the
+	// report to (e.g. "discord", "slack", "telegram", "mattermost", "email",
+	// "matrix", "webhook", "gotify").
+	EnabledNotifiers []string
+
+	SlackWebhookURL string
+
+	TelegramBotToken string
+	TelegramChatID   string
+
+	MattermostWebhookURL string
+
+	MatrixBaseURL     string
+	MatrixRoomID      string
+	MatrixAccessToken string
+
+	WebhookURL string
+
+	GotifyBaseURL string
+	GotifyToken   string
+
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+	SMTPTo       string
+
+	// Summarizer selects which LLM backend generates the daily summary
+	// (e.g. "gemini", "openai", "anthropic", "ollama"). Populated from
+	// LLM_PROVIDER, falling back to the older SUMMARIZER var.
+	Summarizer            string
+	SummarizerModel       string
+	SummarizerTemperature float64
+	SummarizerMaxTokens   int32
+
+	OpenAIAPIKey  string
+	OpenAIBaseURL string
+
+	AnthropicAPIKey string
+
+	OllamaBaseURL string
+
+	// PromptTemplate is the Go text/template source loaded from
+	// PROMPT_TEMPLATE_PATH, if set; empty falls back to the backend's
+	// built-in default template.
+	PromptTemplate string
+
+	// PromptPersona and PromptLocale fill the {{.Persona}} and {{.Locale}}
+	// fields of the prompt template, letting the default template adopt a
+	// different tone or response language without a custom template file.
+	PromptPersona string
+	PromptLocale  string
+
+	// StoreBackend selects the event history persistence layer ("sqlite"
+	// or "json"); empty disables persistence and falls back to a fixed
+	// 24h fetch window.
+	StoreBackend string
+	StorePath    string
 }
 
 // Load reads configuration from environment variables
@@ -30,12 +120,102 @@ func Load() (*Config, error) {
 		geminiModel = defaultGeminiModel
 	}
 
+	summarizerName := firstNonEmpty(os.Getenv("LLM_PROVIDER"), os.Getenv("SUMMARIZER"))
+	if summarizerName == "" {
+		summarizerName = defaultSummarizer
+	}
+
+	summarizerModel := firstNonEmpty(os.Getenv("LLM_MODEL"), os.Getenv("SUMMARIZER_MODEL"))
+	if summarizerModel == "" && summarizerName == defaultSummarizer {
+		summarizerModel = geminiModel
+	}
+
+	summarizerTemp := parseFloat(os.Getenv("SUMMARIZER_TEMPERATURE"), defaultSummarizerTemp)
+	summarizerMaxTokens := parseInt32(os.Getenv("SUMMARIZER_MAX_TOKENS"), defaultSummarizerMaxToken)
+
+	// LLM_API_KEY and LLM_BASE_URL are generic fallbacks shared by every
+	// backend, letting a single pair of vars point the reporter at, say, a
+	// self-hosted OpenAI-compatible server (LocalAI, LM Studio, vLLM) or an
+	// Ollama instance without naming the provider in the var itself. The
+	// provider-specific vars below always win when also set.
+	llmAPIKey := os.Getenv("LLM_API_KEY")
+	llmBaseURL := os.Getenv("LLM_BASE_URL")
+
+	appPrivateKey, err := loadAppPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	promptTemplate, err := loadPromptTemplate()
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &Config{
-		GitHubUser:        os.Getenv("GH_USER"),
-		GitHubToken:       os.Getenv("GH_TOKEN"),
-		GeminiAPIKey:      os.Getenv("GEMINI_API_KEY"),
+		GitHubUser:  os.Getenv("GH_USER"),
+		GitHubToken: os.Getenv("GH_TOKEN"),
+
+		GitHubAppID:             parseInt64(os.Getenv("GITHUB_APP_ID"), 0),
+		GitHubAppInstallationID: parseInt64(os.Getenv("GITHUB_APP_INSTALLATION_ID"), 0),
+		GitHubAppPrivateKey:     appPrivateKey,
+
+		GeminiAPIKey:      firstNonEmpty(os.Getenv("GEMINI_API_KEY"), llmAPIKey),
 		GeminiModel:       geminiModel,
 		DiscordWebhookURL: os.Getenv("DISCORD_WEBHOOK_URL"),
+
+		EnabledSources: parseList(os.Getenv("ENABLED_SOURCES"), defaultEnabledSource),
+
+		GitLabUser:  os.Getenv("GITLAB_USER"),
+		GitLabToken: os.Getenv("GITLAB_TOKEN"),
+
+		BitbucketUser:        os.Getenv("BITBUCKET_USER"),
+		BitbucketAppPassword: os.Getenv("BITBUCKET_APP_PASSWORD"),
+
+		JiraBaseURL:  os.Getenv("JIRA_BASE_URL"),
+		JiraEmail:    os.Getenv("JIRA_EMAIL"),
+		JiraAPIToken: os.Getenv("JIRA_API_TOKEN"),
+
+		EnabledNotifiers: parseList(os.Getenv("ENABLED_NOTIFIERS"), defaultEnabledNotifier),
+
+		SlackWebhookURL: os.Getenv("SLACK_WEBHOOK_URL"),
+
+		TelegramBotToken: os.Getenv("TELEGRAM_BOT_TOKEN"),
+		TelegramChatID:   os.Getenv("TELEGRAM_CHAT_ID"),
+
+		MattermostWebhookURL: os.Getenv("MATTERMOST_WEBHOOK_URL"),
+
+		MatrixBaseURL:     os.Getenv("MATRIX_BASE_URL"),
+		MatrixRoomID:      os.Getenv("MATRIX_ROOM_ID"),
+		MatrixAccessToken: os.Getenv("MATRIX_ACCESS_TOKEN"),
+
+		WebhookURL: os.Getenv("WEBHOOK_URL"),
+
+		GotifyBaseURL: os.Getenv("GOTIFY_BASE_URL"),
+		GotifyToken:   os.Getenv("GOTIFY_TOKEN"),
+
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     os.Getenv("SMTP_PORT"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:     os.Getenv("SMTP_FROM"),
+		SMTPTo:       os.Getenv("SMTP_TO"),
+
+		Summarizer:            summarizerName,
+		SummarizerModel:       summarizerModel,
+		SummarizerTemperature: summarizerTemp,
+		SummarizerMaxTokens:   summarizerMaxTokens,
+
+		OpenAIAPIKey:    firstNonEmpty(os.Getenv("OPENAI_API_KEY"), llmAPIKey),
+		OpenAIBaseURL:   firstNonEmpty(os.Getenv("OPENAI_BASE_URL"), llmBaseURL),
+		AnthropicAPIKey: firstNonEmpty(os.Getenv("ANTHROPIC_API_KEY"), llmAPIKey),
+		OllamaBaseURL:   firstNonEmpty(os.Getenv("OLLAMA_BASE_URL"), llmBaseURL),
+
+		PromptTemplate: promptTemplate,
+		PromptPersona:  os.Getenv("PROMPT_PERSONA"),
+		PromptLocale:   os.Getenv("PROMPT_LOCALE"),
+
+		StoreBackend: os.Getenv("STORE_BACKEND"),
+		StorePath:    os.Getenv("STORE_PATH"),
 	}
 
 	if err := cfg.validate(); err != nil {
@@ -45,15 +225,130 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// parseList splits a comma-separated list of names, falling back to
+// fallback when raw is empty so existing single-backend behavior is
+// preserved.
+func parseList(raw, fallback string) []string {
+	if raw == "" {
+		return []string{fallback}
+	}
+
+	parts := strings.Split(raw, ",")
+	names := make([]string, 0, len(parts))
+	for _, part := range parts {
+		name := strings.TrimSpace(part)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// firstNonEmpty returns the first non-empty value among values, or "" if
+// every one of them is empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// loadAppPrivateKey reads the GitHub App private key from either
+// GITHUB_APP_PRIVATE_KEY (the PEM contents) or GITHUB_APP_PRIVATE_KEY_PATH
+// (a path to a PEM file), returning nil if neither is set.
+func loadAppPrivateKey() ([]byte, error) {
+	if raw := os.Getenv("GITHUB_APP_PRIVATE_KEY"); raw != "" {
+		return []byte(raw), nil
+	}
+
+	path := os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH")
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GITHUB_APP_PRIVATE_KEY_PATH: %w", err)
+	}
+
+	return data, nil
+}
+
+// loadPromptTemplate reads the Go text/template source from
+// PROMPT_TEMPLATE_PATH, returning "" if it's unset so the backend falls
+// back to its built-in default template. The template is parsed (but not
+// executed) here so a malformed file fails fast at startup instead of
+// after the day's events have already been fetched.
+func loadPromptTemplate() (string, error) {
+	path := os.Getenv("PROMPT_TEMPLATE_PATH")
+	if path == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read PROMPT_TEMPLATE_PATH: %w", err)
+	}
+
+	if _, err := template.New("prompt").Parse(string(data)); err != nil {
+		return "", fmt.Errorf("failed to parse PROMPT_TEMPLATE_PATH: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// parseInt64 parses raw as an int64, returning fallback when raw is empty
+// or invalid.
+func parseInt64(raw string, fallback int64) int64 {
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// parseFloat parses raw as a float64, returning fallback when raw is empty
+// or invalid.
+func parseFloat(raw string, fallback float64) float64 {
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// parseInt32 parses raw as an int32, returning fallback when raw is empty
+// or invalid.
+func parseInt32(raw string, fallback int32) int32 {
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return fallback
+	}
+	return int32(value)
+}
+
 // validate checks if all required configuration is present
 func (c *Config) validate() error {
 	if c.GitHubUser == "" {
 		return fmt.Errorf("GH_USER environment variable is required")
 	}
-	if c.GitHubToken == "" {
+	usesApp := c.GitHubAppID != 0 && c.GitHubAppInstallationID != 0 && len(c.GitHubAppPrivateKey) != 0
+	if c.GitHubToken == "" && !usesApp {
 		return fmt.Errorf("GH_TOKEN environment variable is required")
 	}
-	if c.GeminiAPIKey == "" {
+	if c.Summarizer == defaultSummarizer && c.GeminiAPIKey == "" {
 		return fmt.Errorf("GEMINI_API_KEY environment variable is required")
 	}
 	if c.DiscordWebhookURL == "" {
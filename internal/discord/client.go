@@ -2,21 +2,48 @@ package discord
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
 )
 
 const (
-	colorBlue         = 0x7289DA
-	maxDescriptionLen = 4096 // Discord embed description limit
+	colorBlue                    = 0x7289DA
+	maxDescriptionLen            = 4096 // Discord embed description limit
+	maxEmbedsPerMessage          = 10   // Discord webhook limit on embeds per message
+	maxTotalEmbedCharsPerMessage = 6000 // Discord webhook limit on combined embed content per message
+
+	defaultMaxRetries = 3
+	retryBaseDelay    = 20 * time.Millisecond
+	retryMaxDelay     = 200 * time.Millisecond
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o discordfakes/fake_webhook.go . Webhook
+
+// Webhook is the transport Client sends embeds through, extracted from the
+// raw HTTP calls Client used to make directly so tests can fake it with a
+// generated counterfeiter double instead of spinning up an httptest.Server.
+type Webhook interface {
+	// Post fires embeds off as a new webhook message.
+	Post(ctx context.Context, embeds []Embed) error
+	// PostAndWait posts embeds with Discord's ?wait=true so the created
+	// message is returned, letting the caller edit it in place later.
+	PostAndWait(ctx context.Context, embeds []Embed) (messageID string, err error)
+	// Edit replaces the embeds of an already-sent message.
+	Edit(ctx context.Context, messageID string, embeds []Embed) error
+}
+
 // Client handles Discord webhook interactions
 type Client struct {
-	webhookURL string
-	httpClient *http.Client
+	webhook Webhook
 }
 
 // Embed represents a Discord embed message
@@ -26,6 +53,16 @@ type Embed struct {
 	Color       int          `json:"color"`
 	Timestamp   string       `json:"timestamp"`
 	Footer      *EmbedFooter `json:"footer,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+}
+
+// EmbedField represents a single named field within a Discord embed,
+// used to show per-repo commit activity without truncating it into the
+// description.
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
 }
 
 // EmbedFooter represents the footer of a Discord embed
@@ -40,18 +77,59 @@ type WebhookPayload struct {
 
 // NewClient creates a new Discord webhook client
 func NewClient(webhookURL string) *Client {
-	return &Client{
-		webhookURL: webhookURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+	return NewClientWithWebhook(newHTTPWebhook(webhookURL))
+}
+
+// NewClientWithWebhook creates a new Discord client against a custom
+// Webhook implementation (used in tests to inject a counterfeiter fake).
+func NewClientWithWebhook(webhook Webhook) *Client {
+	return &Client{webhook: webhook}
+}
+
+// SetMaxRetries overrides the number of retry attempts the underlying
+// Webhook makes on 429 and 5xx responses before giving up. It is a no-op
+// when the Client was built around a custom Webhook (e.g. a fake in
+// tests) that doesn't support it.
+func (c *Client) SetMaxRetries(maxRetries int) {
+	if hw, ok := c.webhook.(*httpWebhook); ok {
+		hw.SetMaxRetries(maxRetries)
 	}
 }
 
-// SendDailyReport sends the daily GitHub report to Discord
+// RateLimit returns the rate-limit state observed on the most recent
+// webhook response, or the zero value if the Client's Webhook doesn't
+// track one.
+func (c *Client) RateLimit() RateLimit {
+	if hw, ok := c.webhook.(*httpWebhook); ok {
+		return hw.RateLimit()
+	}
+	return RateLimit{}
+}
+
+// SendDailyReport sends the daily GitHub report to Discord as one or more
+// embeds built from a pre-formatted message (typically an LLM summary),
+// split on sentence boundaries when it exceeds maxDescriptionLen.
+// It is a wrapper around SendDailyReportContext using context.Background();
+// prefer the context-aware version when the caller has a deadline or wants
+// to cancel a slow send.
 func (c *Client) SendDailyReport(message string) error {
-	embed := Embed{
-		Title:       "GitHub Daily",
+	return c.SendDailyReportContext(context.Background(), message)
+}
+
+// SendDailyReportContext is SendDailyReport with a caller-supplied context.
+// This is a fallback path for callers that don't have a structured
+// report.DailyReport available; prefer SendDailyReportStructuredContext
+// when one is.
+func (c *Client) SendDailyReportContext(ctx context.Context, message string) error {
+	return c.sendEmbeds(ctx, c.buildReportEmbeds(message))
+}
+
+// buildReportEmbed renders message into the single embed used by the
+// streaming report send, where every chunk replaces the same message in
+// place and so can't be split across multiple embeds.
+func (c *Client) buildReportEmbed(message string) Embed {
+	return Embed{
+		Title:       fmt.Sprintf("GitHub Daily - %s", time.Now().Format("2006-01-02")),
 		Description: truncateMessage(message, maxDescriptionLen),
 		Color:       colorBlue,
 		Timestamp:   time.Now().Format(time.RFC3339),
@@ -59,8 +137,97 @@ func (c *Client) SendDailyReport(message string) error {
 			Text: "GitHub Daily Reporter",
 		},
 	}
+}
+
+// buildReportEmbeds splits message across as many embeds as it takes to
+// stay within maxDescriptionLen each, preserving sentence boundaries,
+// rather than truncating it to a single embed. sendEmbeds then batches
+// the result across multiple webhook messages if needed.
+func (c *Client) buildReportEmbeds(message string) []Embed {
+	chunks := splitIntoChunks(message, maxDescriptionLen)
+	now := time.Now()
 
-	return c.sendEmbed(embed)
+	embeds := make([]Embed, 0, len(chunks))
+	for i, chunk := range chunks {
+		title := fmt.Sprintf("GitHub Daily - %s", now.Format("2006-01-02"))
+		if len(chunks) > 1 {
+			title = fmt.Sprintf("%s (%d/%d)", title, i+1, len(chunks))
+		}
+
+		embeds = append(embeds, Embed{
+			Title:       title,
+			Description: chunk,
+			Color:       colorBlue,
+			Timestamp:   now.Format(time.RFC3339),
+			Footer: &EmbedFooter{
+				Text: "GitHub Daily Reporter",
+			},
+		})
+	}
+
+	return embeds
+}
+
+// SendDailyReportStream posts an initial embed for the report, then edits
+// that same message in place via PATCH every time a new chunk arrives on
+// chunks, so a long summary streaming in from the summarizer (e.g.
+// gemini.Client.GenerateDailySummaryStream) is shown progressively instead
+// of only appearing once it's complete. The caller is responsible for
+// closing chunks once the summary is done; the last chunk sent is left as
+// the message's final content.
+//
+// cmd/reporter doesn't call this: it reaches notifiers through the generic
+// notify.Notifier fan-out, and progressive editing only makes sense when
+// the notifier is known to be Discord and the summarizer known to be
+// Gemini. This is exposed for callers that wire the two directly instead
+// of going through notify.Multi.
+func (c *Client) SendDailyReportStream(ctx context.Context, chunks <-chan string) error {
+	messageID, err := c.webhook.PostAndWait(ctx, []Embed{c.buildReportEmbed("")})
+	if err != nil {
+		return fmt.Errorf("failed to post initial message: %w", err)
+	}
+
+	for text := range chunks {
+		if err := c.webhook.Edit(ctx, messageID, []Embed{c.buildReportEmbed(text)}); err != nil {
+			return fmt.Errorf("failed to edit streamed message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SendDailyReportStructured renders dr into one or more embeds with
+// per-repo fields (rather than a single truncated description) and sends
+// them, batched across as many webhook messages as Discord's per-message
+// embed limits require. It is a wrapper around
+// SendDailyReportStructuredContext using context.Background().
+func (c *Client) SendDailyReportStructured(dr report.DailyReport) error {
+	return c.SendDailyReportStructuredContext(context.Background(), dr)
+}
+
+// SendDailyReportStructuredContext is SendDailyReportStructured with a
+// caller-supplied context.
+func (c *Client) SendDailyReportStructuredContext(ctx context.Context, dr report.DailyReport) error {
+	rendered := report.DiscordEmbedRenderer{}.RenderEmbeds(dr)
+
+	embeds := make([]Embed, 0, len(rendered))
+	for _, re := range rendered {
+		fields := make([]EmbedField, 0, len(re.Fields))
+		for _, f := range re.Fields {
+			fields = append(fields, EmbedField{Name: f.Name, Value: f.Value, Inline: f.Inline})
+		}
+
+		embeds = append(embeds, Embed{
+			Title:       re.Title,
+			Description: re.Description,
+			Color:       colorBlue,
+			Timestamp:   time.Now().Format(time.RFC3339),
+			Footer:      &EmbedFooter{Text: "GitHub Daily Reporter"},
+			Fields:      fields,
+		})
+	}
+
+	return c.sendEmbeds(ctx, embeds)
 }
 
 // truncateMessage ensures the message fits within Discord's limits
@@ -88,8 +255,44 @@ func truncateMessage(message string, maxLength int) string {
 	return truncated
 }
 
-// SendError sends an error message to Discord
+// splitIntoChunks breaks message into pieces no longer than maxLen each,
+// preferring to break on the same trailing period truncateMessage looks
+// for so a long summary reads as complete sentences across embeds
+// instead of being cut off mid-word.
+func splitIntoChunks(message string, maxLen int) []string {
+	var chunks []string
+
+	for len(message) > maxLen {
+		cut := lastSentenceBoundary(message, maxLen)
+		chunks = append(chunks, message[:cut])
+		message = message[cut:]
+	}
+
+	return append(chunks, message)
+}
+
+// lastSentenceBoundary returns the index just after the last period at or
+// before maxLen, or maxLen itself if no period is found.
+func lastSentenceBoundary(message string, maxLen int) int {
+	truncated := message[:maxLen]
+
+	for i := len(truncated) - 1; i >= 0; i-- {
+		if truncated[i] == '.' {
+			return i + 1
+		}
+	}
+
+	return maxLen
+}
+
+// SendError sends an error message to Discord. It is a wrapper around
+// SendErrorContext using context.Background().
 func (c *Client) SendError(err error) error {
+	return c.SendErrorContext(context.Background(), err)
+}
+
+// SendErrorContext is SendError with a caller-supplied context.
+func (c *Client) SendErrorContext(ctx context.Context, err error) error {
 	embed := Embed{
 		Title:       "GitHub Daily Reporter - Error",
 		Description: fmt.Sprintf("Error occurred: %v", err),
@@ -100,45 +303,299 @@ func (c *Client) SendError(err error) error {
 		},
 	}
 
-	return c.sendEmbed(embed)
+	return c.sendEmbeds(ctx, []Embed{embed})
+}
+
+// sendEmbeds sends embeds to Discord via webhook, splitting them across
+// multiple messages if there are more embeds than Discord's per-message
+// limit or their combined content exceeds the per-message character
+// limit.
+func (c *Client) sendEmbeds(ctx context.Context, embeds []Embed) error {
+	for len(embeds) > 0 {
+		batch, rest := batchEmbeds(embeds)
+
+		if err := c.webhook.Post(ctx, batch); err != nil {
+			return err
+		}
+
+		embeds = rest
+	}
+
+	return nil
+}
+
+// batchEmbeds splits off a leading batch of embeds that fits within
+// Discord's maxEmbedsPerMessage and maxTotalEmbedCharsPerMessage limits,
+// returning it along with whatever embeds are left over.
+func batchEmbeds(embeds []Embed) (batch, rest []Embed) {
+	total := 0
+
+	for i, e := range embeds {
+		size := embedSize(e)
+		if i > 0 && (i >= maxEmbedsPerMessage || total+size > maxTotalEmbedCharsPerMessage) {
+			return embeds[:i], embeds[i:]
+		}
+		total += size
+	}
+
+	return embeds, nil
+}
+
+// embedSize approximates how much of Discord's combined embed character
+// budget e consumes, summing its title, description, footer, and field
+// text.
+func embedSize(e Embed) int {
+	size := len(e.Title) + len(e.Description)
+
+	for _, f := range e.Fields {
+		size += len(f.Name) + len(f.Value)
+	}
+
+	if e.Footer != nil {
+		size += len(e.Footer.Text)
+	}
+
+	return size
 }
 
-// sendEmbed sends an embed to Discord via webhook
-func (c *Client) sendEmbed(embed Embed) error {
-	payload := WebhookPayload{
-		Embeds: []Embed{embed},
+// webhookMessage is the subset of Discord's message object returned when a
+// webhook POST is made with ?wait=true, used to capture the message ID so
+// PostAndWait can hand it back for later edits.
+type webhookMessage struct {
+	ID string `json:"id"`
+}
+
+// RateLimit is the most recently observed Discord webhook rate-limit
+// state, as reported by the X-RateLimit-* response headers.
+type RateLimit struct {
+	Remaining  int
+	ResetAfter time.Duration
+}
+
+// httpWebhook is the real Webhook implementation, posting and patching a
+// Discord webhook URL directly over HTTP.
+type httpWebhook struct {
+	webhookURL string
+	httpClient *http.Client
+	maxRetries int
+
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimit
+}
+
+// newHTTPWebhook creates an httpWebhook pointed at webhookURL.
+func newHTTPWebhook(webhookURL string) *httpWebhook {
+	return &httpWebhook{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		maxRetries: defaultMaxRetries,
 	}
+}
 
-	jsonData, err := json.Marshal(payload)
+// SetMaxRetries overrides the number of retry attempts doWithRetry makes
+// on 429 and 5xx responses before giving up.
+func (w *httpWebhook) SetMaxRetries(maxRetries int) {
+	w.maxRetries = maxRetries
+}
+
+// RateLimit returns the rate-limit state observed on the most recent
+// response.
+func (w *httpWebhook) RateLimit() RateLimit {
+	w.rateLimitMu.Lock()
+	defer w.rateLimitMu.Unlock()
+	return w.rateLimit
+}
+
+// Post implements Webhook.
+func (w *httpWebhook) Post(ctx context.Context, embeds []Embed) error {
+	resp, err := w.doWithRetry(ctx, func() (*http.Request, error) {
+		return w.newRequest(ctx, http.MethodPost, w.webhookURL, embeds)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
+		return err
 	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
 
-	req, err := http.NewRequest(
-		http.MethodPost,
-		c.webhookURL,
-		bytes.NewBuffer(jsonData),
-	)
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// PostAndWait implements Webhook.
+func (w *httpWebhook) PostAndWait(ctx context.Context, embeds []Embed) (string, error) {
+	resp, err := w.doWithRetry(ctx, func() (*http.Request, error) {
+		return w.newRequest(ctx, http.MethodPost, w.webhookURL+"?wait=true", embeds)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", err
 	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
 
-	req.Header.Set("Content-Type", "application/json")
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	var msg webhookMessage
+	if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+		return "", fmt.Errorf("failed to decode webhook response: %w", err)
+	}
+
+	return msg.ID, nil
+}
+
+// Edit implements Webhook via PATCH /webhooks/{id}/{token}/messages/{message_id}.
+func (w *httpWebhook) Edit(ctx context.Context, messageID string, embeds []Embed) error {
+	resp, err := w.doWithRetry(ctx, func() (*http.Request, error) {
+		url := fmt.Sprintf("%s/messages/%s", w.webhookURL, messageID)
+		return w.newRequest(ctx, http.MethodPatch, url, embeds)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return err
 	}
 	defer func() {
 		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
 	}()
 
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf(
-			"unexpected status code: %d",
-			resp.StatusCode,
-		)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
 	return nil
 }
+
+// newRequest builds a JSON request for embeds against url. It's called
+// fresh on every retry attempt so a body already consumed by a prior
+// attempt doesn't get resent empty.
+func (w *httpWebhook) newRequest(ctx context.Context, method, url string, embeds []Embed) (*http.Request, error) {
+	jsonData, err := json.Marshal(WebhookPayload{Embeds: embeds})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// doWithRetry executes a request built fresh by newReq on every attempt,
+// retrying 429 and 5xx responses up to w.maxRetries times with
+// exponential backoff and jitter. On a 429 it honors the retry_after
+// field in Discord's JSON body when present, falling back to the
+// backoff otherwise. It also records the rate-limit state reported on
+// the X-RateLimit-* headers of the most recent response.
+func (w *httpWebhook) doWithRetry(ctx context.Context, newReq func() (*http.Request, error)) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("request canceled: %w", err)
+		}
+
+		req, err := newReq()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := w.httpClient.Do(req)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, fmt.Errorf("request canceled: %w", ctxErr)
+			}
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		w.recordRateLimit(resp.Header)
+
+		isRetryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		if !isRetryable || attempt >= w.maxRetries {
+			return resp, nil
+		}
+
+		wait := backoffWithJitter(attempt)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if retryAfter, ok := retryAfterFromBody(resp.Body); ok {
+				wait = retryAfter
+			}
+		}
+
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("request canceled: %w", err)
+		}
+	}
+}
+
+// recordRateLimit updates the webhook's last-observed rate-limit state
+// from response headers.
+func (w *httpWebhook) recordRateLimit(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	resetAfterSeconds, err := strconv.ParseFloat(header.Get("X-RateLimit-Reset-After"), 64)
+	if err != nil {
+		return
+	}
+
+	w.rateLimitMu.Lock()
+	w.rateLimit = RateLimit{
+		Remaining:  remaining,
+		ResetAfter: time.Duration(resetAfterSeconds * float64(time.Second)),
+	}
+	w.rateLimitMu.Unlock()
+}
+
+// rateLimitBody is the subset of Discord's 429 response body used to
+// honor its requested backoff.
+type rateLimitBody struct {
+	RetryAfter float64 `json:"retry_after"`
+}
+
+// retryAfterFromBody reads and decodes the retry_after field (seconds,
+// possibly fractional) from a 429 response body.
+func retryAfterFromBody(body io.Reader) (time.Duration, bool) {
+	var parsed rateLimitBody
+	if err := json.NewDecoder(body).Decode(&parsed); err != nil || parsed.RetryAfter <= 0 {
+		return 0, false
+	}
+	return time.Duration(parsed.RetryAfter * float64(time.Second)), true
+}
+
+// sleepContext waits for d, returning early with ctx.Err() if ctx is
+// canceled or its deadline expires first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backoffWithJitter returns an exponentially growing delay for the given
+// retry attempt (0-indexed), capped at retryMaxDelay and jittered by up
+// to 50% to avoid a thundering herd of synchronized retries.
+func backoffWithJitter(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay) / 2)) //nolint:gosec // non-cryptographic jitter
+	return delay/2 + jitter
+}
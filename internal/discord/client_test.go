@@ -1,22 +1,32 @@
 package discord
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/discord/discordfakes"
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
 )
 
 func TestNewClient(t *testing.T) {
 	webhookURL := "https://discord.com/api/webhooks/123/abc"
 	client := NewClient(webhookURL)
 
-	if client.webhookURL != webhookURL {
-		t.Errorf("Expected webhookURL=%s, got: %s", webhookURL, client.webhookURL)
+	httpWh, ok := client.webhook.(*httpWebhook)
+	if !ok {
+		t.Fatalf("Expected webhook to be an *httpWebhook, got: %T", client.webhook)
+	}
+	if httpWh.webhookURL != webhookURL {
+		t.Errorf("Expected webhookURL=%s, got: %s", webhookURL, httpWh.webhookURL)
 	}
-	if client.httpClient == nil {
+	if httpWh.httpClient == nil {
 		t.Error("Expected httpClient to be initialized")
 	}
 }
@@ -268,6 +278,102 @@ func TestWebhookPayload(t *testing.T) {
 	}
 }
 
+func TestSendDailyReportStructured_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode payload: %v", err)
+		}
+
+		if len(payload.Embeds) != 1 {
+			t.Fatalf("Expected 1 embed, got %d", len(payload.Embeds))
+		}
+
+		embed := payload.Embeds[0]
+		// The "Activity" counts field comes first, followed by the repo field.
+		if len(embed.Fields) != 2 {
+			t.Fatalf("Expected 2 fields, got %d", len(embed.Fields))
+		}
+		if embed.Fields[1].Name != "carvalhocaio/routines-in-the-night" {
+			t.Errorf("Expected field name=repo, got: %s", embed.Fields[1].Name)
+		}
+		if embed.Fields[1].Value != "3 commit(s)" {
+			t.Errorf("Expected field value='3 commit(s)', got: %s", embed.Fields[1].Value)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "carvalhocaio/routines-in-the-night", Commits: 3},
+	}
+	dr := report.NewDailyReport(events, time.Time{}, time.Time{})
+
+	client := NewClient(server.URL)
+	err := client.SendDailyReportStructured(dr)
+
+	if err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestSendDailyReportStructured_BatchesOverEmbedLimit(t *testing.T) {
+	messageCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		messageCount++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+
+	embeds := make([]Embed, maxEmbedsPerMessage+1)
+	if err := client.sendEmbeds(context.Background(), embeds); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	if messageCount != 2 {
+		t.Errorf("Expected 2 messages for %d embeds, got %d", len(embeds), messageCount)
+	}
+}
+
+func TestSendDailyReportContext_CancelStopsInFlightRequest(t *testing.T) {
+	started := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(2 * time.Second)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.SendDailyReportContext(ctx, "Test message")
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Expected error after cancellation")
+		}
+		if !strings.Contains(err.Error(), "context canceled") {
+			t.Errorf("Expected cancellation error, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected SendDailyReportContext to return promptly after cancel")
+	}
+}
+
 func TestSendEmbed_InvalidURL(t *testing.T) {
 	client := NewClient("://invalid-url")
 	err := client.SendDailyReport("Test")
@@ -279,3 +385,304 @@ func TestSendEmbed_InvalidURL(t *testing.T) {
 		t.Errorf("Expected request creation error, got: %v", err)
 	}
 }
+
+// The tests below exercise Client's orchestration logic (batching, error
+// propagation, streaming edits) against a fake Webhook instead of an
+// httptest.Server, so they can assert on call counts and arguments without
+// a network round trip.
+
+func TestSendDailyReport_UsesFakeWebhook(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	client := NewClientWithWebhook(fake)
+
+	if err := client.SendDailyReport("Test message"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if fake.PostCallCount() != 1 {
+		t.Fatalf("Expected Post called once, got: %d", fake.PostCallCount())
+	}
+
+	_, embeds := fake.PostArgsForCall(0)
+	if len(embeds) != 1 {
+		t.Fatalf("Expected 1 embed, got: %d", len(embeds))
+	}
+	if embeds[0].Description != "Test message" {
+		t.Errorf("Expected description 'Test message', got: %s", embeds[0].Description)
+	}
+}
+
+func TestSendDailyReport_PropagatesWebhookError(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	fake.PostReturns(errors.New("rate limited"))
+
+	client := NewClientWithWebhook(fake)
+
+	err := client.SendDailyReport("Test message")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "rate limited") {
+		t.Errorf("Expected error to contain 'rate limited', got: %v", err)
+	}
+}
+
+func TestSendDailyReportStructured_BatchesOverEmbedLimit_FakeWebhook(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	client := NewClientWithWebhook(fake)
+
+	embeds := make([]Embed, maxEmbedsPerMessage+1)
+	if err := client.sendEmbeds(context.Background(), embeds); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if fake.PostCallCount() != 2 {
+		t.Fatalf("Expected 2 Post calls for %d embeds, got: %d", len(embeds), fake.PostCallCount())
+	}
+
+	_, firstBatch := fake.PostArgsForCall(0)
+	if len(firstBatch) != maxEmbedsPerMessage {
+		t.Errorf("Expected first batch of %d embeds, got: %d", maxEmbedsPerMessage, len(firstBatch))
+	}
+	_, secondBatch := fake.PostArgsForCall(1)
+	if len(secondBatch) != 1 {
+		t.Errorf("Expected second batch of 1 embed, got: %d", len(secondBatch))
+	}
+}
+
+func TestSendError_RendersErrorEmbed(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	client := NewClientWithWebhook(fake)
+
+	if err := client.SendError(errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	_, embeds := fake.PostArgsForCall(0)
+	if embeds[0].Title != "GitHub Daily Reporter - Error" {
+		t.Errorf("Expected error title, got: %s", embeds[0].Title)
+	}
+	if embeds[0].Color != 0xFF0000 {
+		t.Errorf("Expected red color, got: %d", embeds[0].Color)
+	}
+	if !strings.Contains(embeds[0].Description, "boom") {
+		t.Errorf("Expected description to contain 'boom', got: %s", embeds[0].Description)
+	}
+}
+
+func TestSendDailyReportStream_PostsThenEditsPerChunk(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	fake.PostAndWaitReturns("message-1", nil)
+
+	client := NewClientWithWebhook(fake)
+
+	chunks := make(chan string, 2)
+	chunks <- "Parte 1."
+	chunks <- "Parte 1. Parte 2."
+	close(chunks)
+
+	if err := client.SendDailyReportStream(context.Background(), chunks); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if fake.PostAndWaitCallCount() != 1 {
+		t.Fatalf("Expected PostAndWait called once, got: %d", fake.PostAndWaitCallCount())
+	}
+	if fake.EditCallCount() != 2 {
+		t.Fatalf("Expected Edit called twice, got: %d", fake.EditCallCount())
+	}
+
+	_, messageID, embeds := fake.EditArgsForCall(1)
+	if messageID != "message-1" {
+		t.Errorf("Expected edits to target message-1, got: %s", messageID)
+	}
+	if embeds[0].Description != "Parte 1. Parte 2." {
+		t.Errorf("Expected final edit description to be the last chunk, got: %s", embeds[0].Description)
+	}
+}
+
+func TestSendDailyReportStream_PostAndWaitError(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	fake.PostAndWaitReturns("", errors.New("webhook unreachable"))
+
+	client := NewClientWithWebhook(fake)
+
+	chunks := make(chan string)
+	close(chunks)
+
+	err := client.SendDailyReportStream(context.Background(), chunks)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to post initial message") {
+		t.Errorf("Expected error to contain 'failed to post initial message', got: %v", err)
+	}
+	if fake.EditCallCount() != 0 {
+		t.Errorf("Expected no edits when the initial post fails, got: %d", fake.EditCallCount())
+	}
+}
+
+func TestSendDailyReportStream_EditError(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	fake.PostAndWaitReturns("message-1", nil)
+	fake.EditReturns(errors.New("edit failed"))
+
+	client := NewClientWithWebhook(fake)
+
+	chunks := make(chan string, 1)
+	chunks <- "Parte 1."
+	close(chunks)
+
+	err := client.SendDailyReportStream(context.Background(), chunks)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to edit streamed message") {
+		t.Errorf("Expected error to contain 'failed to edit streamed message', got: %v", err)
+	}
+}
+
+func TestSendDailyReport_RetriesOn429WithRetryAfter(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0.01,"global":false}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	if err := client.SendDailyReport("Test message"); err != nil {
+		t.Fatalf("Expected no error after retry, got: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts (one throttled, one retried), got: %d", attempts)
+	}
+}
+
+func TestSendDailyReport_RetriesServerErrorWithBackoff(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	if err := client.SendDailyReport("Test message"); err != nil {
+		t.Fatalf("Expected no error after retries, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts before success, got: %d", attempts)
+	}
+}
+
+func TestSendDailyReport_GivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	client.SetMaxRetries(1)
+
+	err := client.SendDailyReport("Test message")
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+	if !strings.Contains(err.Error(), "unexpected status code: 500") {
+		t.Errorf("Expected status code error, got: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts (1 initial + 1 retry), got: %d", attempts)
+	}
+}
+
+func TestSendDailyReport_RecordsRateLimitFromHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "3")
+		w.Header().Set("X-RateLimit-Reset-After", "1.250")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	if err := client.SendDailyReport("Test message"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	limit := client.RateLimit()
+	if limit.Remaining != 3 {
+		t.Errorf("Expected RateLimit().Remaining=3, got: %d", limit.Remaining)
+	}
+	if limit.ResetAfter != 1250*time.Millisecond {
+		t.Errorf("Expected RateLimit().ResetAfter=1.25s, got: %v", limit.ResetAfter)
+	}
+}
+
+func TestSplitIntoChunks_PreservesSentenceBoundaries(t *testing.T) {
+	message := strings.Repeat("This is a sentence. ", 300)
+
+	chunks := splitIntoChunks(message, maxDescriptionLen)
+
+	if len(chunks) < 2 {
+		t.Fatalf("Expected message to split into multiple chunks, got: %d", len(chunks))
+	}
+
+	var rebuilt strings.Builder
+	for _, chunk := range chunks {
+		if len(chunk) > maxDescriptionLen {
+			t.Errorf("Expected chunk length <= %d, got: %d", maxDescriptionLen, len(chunk))
+		}
+		rebuilt.WriteString(chunk)
+	}
+	if rebuilt.String() != message {
+		t.Error("Expected chunks to reconstruct the original message")
+	}
+	if !strings.HasSuffix(chunks[0], ".") {
+		t.Errorf("Expected first chunk to end on a sentence boundary, got suffix: %q", chunks[0][len(chunks[0])-10:])
+	}
+}
+
+func TestSendDailyReport_SplitsLongMessageAcrossEmbeds(t *testing.T) {
+	fake := &discordfakes.FakeWebhook{}
+	client := NewClientWithWebhook(fake)
+
+	longMessage := strings.Repeat("This is a sentence. ", 300)
+	if err := client.SendDailyReport(longMessage); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if fake.PostCallCount() == 0 {
+		t.Fatal("Expected at least one Post call")
+	}
+
+	var rebuilt strings.Builder
+	for i := 0; i < fake.PostCallCount(); i++ {
+		_, embeds := fake.PostArgsForCall(i)
+		for _, e := range embeds {
+			if len(e.Description) > maxDescriptionLen {
+				t.Errorf("Expected embed description <= %d, got: %d", maxDescriptionLen, len(e.Description))
+			}
+			rebuilt.WriteString(e.Description)
+		}
+	}
+	if rebuilt.String() != longMessage {
+		t.Error("Expected embeds across all Post calls to reconstruct the original message")
+	}
+}
@@ -1,13 +1,20 @@
 package gemini
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"text/template"
+	"time"
 
-	"github.com/carvalhocaio/routines-in-the-night/internal/github"
+	"github.com/carvalhocaio/routines-in-the-night/internal/gemini/prompts"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer"
 	"github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -15,15 +22,34 @@ const (
 	maxTokens       = 8192
 	temperature     = 1.2
 	maxSummaryChars = 4096 // Discord embed description limit (max is 4096)
+
+	// defaultInputTokenLimit is used when Model.Info doesn't report an
+	// InputTokenLimit, so map-reduce chunking still has a budget to work with.
+	defaultInputTokenLimit = 32000
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o geminifakes/fake_generative_model.go . GenerativeModel
+
 // GenerativeModel defines the interface for AI model operations
 type GenerativeModel interface {
 	GenerateContent(ctx context.Context, parts ...genai.Part) (*genai.GenerateContentResponse, error)
+	GenerateContentStream(ctx context.Context, parts ...genai.Part) ContentIterator
+	CountTokens(ctx context.Context, parts ...genai.Part) (*genai.CountTokensResponse, error)
+	Info(ctx context.Context) (*genai.ModelInfo, error)
 	SetTemperature(temp float32)
 	SetMaxOutputTokens(tokens int32)
 }
 
+// ContentIterator is satisfied by *genai.GenerateContentResponseIterator, the
+// concrete type GenerateContentStream returns. Wrapping it as an interface
+// lets tests fake streaming responses without a live API call; Next returns
+// iterator.Done once the stream is exhausted.
+type ContentIterator interface {
+	Next() (*genai.GenerateContentResponse, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o geminifakes/fake_gen_ai_client.go . GenAIClient
+
 // GenAIClient defines the interface for the Gemini client
 type GenAIClient interface {
 	GenerativeModel(name string) GenerativeModel
@@ -36,13 +62,50 @@ type genaiClientWrapper struct {
 }
 
 func (w *genaiClientWrapper) GenerativeModel(name string) GenerativeModel {
-	return w.client.GenerativeModel(name)
+	return &genaiModelWrapper{model: w.client.GenerativeModel(name)}
 }
 
 func (w *genaiClientWrapper) Close() error {
 	return w.client.Close()
 }
 
+// genaiModelWrapper wraps the real *genai.GenerativeModel to implement
+// GenerativeModel; it only exists because GenerateContentStream's real
+// return type is a concrete iterator, which needs adapting to ContentIterator.
+type genaiModelWrapper struct {
+	model *genai.GenerativeModel
+}
+
+func (w *genaiModelWrapper) GenerateContent(
+	ctx context.Context,
+	parts ...genai.Part,
+) (*genai.GenerateContentResponse, error) {
+	return w.model.GenerateContent(ctx, parts...)
+}
+
+func (w *genaiModelWrapper) GenerateContentStream(ctx context.Context, parts ...genai.Part) ContentIterator {
+	return w.model.GenerateContentStream(ctx, parts...)
+}
+
+func (w *genaiModelWrapper) CountTokens(
+	ctx context.Context,
+	parts ...genai.Part,
+) (*genai.CountTokensResponse, error) {
+	return w.model.CountTokens(ctx, parts...)
+}
+
+func (w *genaiModelWrapper) Info(ctx context.Context) (*genai.ModelInfo, error) {
+	return w.model.Info(ctx)
+}
+
+func (w *genaiModelWrapper) SetTemperature(temp float32) {
+	w.model.SetTemperature(temp)
+}
+
+func (w *genaiModelWrapper) SetMaxOutputTokens(tokens int32) {
+	w.model.SetMaxOutputTokens(tokens)
+}
+
 // ClientFactory creates GenAI clients
 type ClientFactory func(ctx context.Context, apiKey string) (GenAIClient, error)
 
@@ -60,6 +123,13 @@ type Client struct {
 	apiKey        string
 	modelName     string
 	clientFactory ClientFactory
+
+	// promptTemplate is the Go text/template used to render the daily
+	// summary prompt; empty means prompts.Default. Set via WithPrompt.
+	promptTemplate string
+	user           string
+	locale         string
+	persona        string
 }
 
 // NewClient creates a new Gemini API client
@@ -80,16 +150,54 @@ func NewClientWithFactory(apiKey, modelName string, factory ClientFactory) *Clie
 	}
 }
 
+// WithPrompt overrides the daily-summary prompt template with a custom Go
+// text/template, rendered with named fields {{.Events}}, {{.User}},
+// {{.Date}}, {{.Locale}}, and {{.Persona}}. Passing "" reverts to the
+// built-in prompts.Default template. Returns c for chaining off NewClient.
+func (c *Client) WithPrompt(tmpl string) *Client {
+	c.promptTemplate = tmpl
+	return c
+}
+
+// SetUser sets the {{.User}} value available to the prompt template,
+// typically the GitHub username the day's events belong to.
+func (c *Client) SetUser(user string) {
+	c.user = user
+}
+
+// SetLocale sets the {{.Locale}} value available to the prompt template
+// (e.g. "pt-BR", "en-US"), letting a custom template request a specific
+// response language without changing the template itself.
+func (c *Client) SetLocale(locale string) {
+	c.locale = locale
+}
+
+// SetPersona sets the {{.Persona}} value available to the prompt
+// template (e.g. "pirate", "haiku", "formal PT-BR"), letting the default
+// template adopt a different tone without a custom PROMPT_TEMPLATE_PATH.
+func (c *Client) SetPersona(persona string) {
+	c.persona = persona
+}
+
 // GenerateDailySummary creates an AI-generated summary of GitHub events
 func (c *Client) GenerateDailySummary(
-	events []github.FormattedEvent,
+	events []source.FormattedEvent,
+) (string, error) {
+	return c.Summarize(context.Background(), events)
+}
+
+// Summarize implements summarizer.Summarizer. If events don't fit the
+// model's input token budget in one request, they're chunked and
+// summarized via the same map-reduce pipeline used by
+// GenerateDailySummaryStream, so large days don't simply fail.
+func (c *Client) Summarize(
+	ctx context.Context,
+	events []source.FormattedEvent,
 ) (string, error) {
 	if len(events) == 0 {
 		return "Hoje foi um dia de planejamento e reflexão no código.", nil
 	}
 
-	ctx := context.Background()
-
 	// Initialize Gemini Client
 	client, err := c.clientFactory(ctx, c.apiKey)
 	if err != nil {
@@ -104,8 +212,9 @@ func (c *Client) GenerateDailySummary(
 	model.SetTemperature(float32(temperature))
 	model.SetMaxOutputTokens(int32(maxTokens))
 
-	// Build the prompt
-	prompt, err := c.buildPrompt(events)
+	// Build the prompt, falling back to map-reduce chunking if the events
+	// don't fit the model's input token budget in one request.
+	prompt, err := c.buildBudgetedPrompt(ctx, model, events)
 	if err != nil {
 		return "", fmt.Errorf("failed to build prompt: %w", err)
 	}
@@ -126,16 +235,308 @@ func (c *Client) GenerateDailySummary(
 	return c.truncateSummary(summary), nil
 }
 
-// buildPrompt creates the prompt for Gemini based on events
-func (c *Client) buildPrompt(events []github.FormattedEvent) (string, error) {
+// promptData is the set of named fields available to a prompt template:
+// {{.Events}}, {{.User}}, {{.Date}}, {{.Locale}}, and {{.Persona}}.
+type promptData struct {
+	Events  string
+	User    string
+	Date    string
+	Locale  string
+	Persona string
+}
+
+// buildPrompt renders the active prompt template (c.promptTemplate if set
+// via WithPrompt, otherwise the built-in prompts.Default) with the day's
+// events and the client's user/locale/persona settings.
+func (c *Client) buildPrompt(events []source.FormattedEvent) (string, error) {
 	eventsJSON, err := json.MarshalIndent(events, "", " ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal events: %w", err)
 	}
 
-	prompt := fmt.Sprintf(dailySummaryPromptTemplate, eventsJSON)
+	tmplText := c.promptTemplate
+	if tmplText == "" {
+		tmplText = prompts.Default
+	}
+
+	tmpl, err := template.New("prompt").Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse prompt template: %w", err)
+	}
+
+	data := promptData{
+		Events:  string(eventsJSON),
+		User:    c.user,
+		Date:    time.Now().Format("2006-01-02"),
+		Locale:  c.locale,
+		Persona: c.persona,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateDailySummaryStream generates the daily summary the same way as
+// Summarize, but streams the response as it is produced: onChunk is called
+// with the summary text accumulated so far every time new content arrives,
+// so callers (e.g. the Discord notifier editing its webhook message in
+// place) can show progress instead of blocking until the whole response is
+// ready. The final return value is the full, truncated summary, matching
+// Summarize's result.
+//
+// If the built prompt would exceed the model's input token limit, events
+// are chunked into batches that each fit the budget, each batch is
+// summarized independently, and a final reduce pass merges the batch
+// summaries into one cohesive summary; only that final pass is streamed.
+//
+// cmd/reporter doesn't call this: the runner talks to the summarizer and
+// notifiers through the generic summarizer.Summarizer and notify.Notifier
+// interfaces so any backend can be swapped in, and progressive editing only
+// makes sense for a single Gemini+Discord pairing. This is exposed for
+// callers that wire Gemini directly to a discord.Client.
+func (c *Client) GenerateDailySummaryStream(
+	ctx context.Context,
+	events []source.FormattedEvent,
+	onChunk func(text string) error,
+) (string, error) {
+	if len(events) == 0 {
+		summary := "Hoje foi um dia de planejamento e reflexão no código."
+		if onChunk != nil {
+			if err := onChunk(summary); err != nil {
+				return "", err
+			}
+		}
+		return summary, nil
+	}
+
+	client, err := c.clientFactory(ctx, c.apiKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	defer func() {
+		_ = client.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	model := client.GenerativeModel(c.modelName)
+	model.SetTemperature(float32(temperature))
+	model.SetMaxOutputTokens(int32(maxTokens))
+
+	prompt, err := c.buildBudgetedPrompt(ctx, model, events)
+	if err != nil {
+		return "", fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	summary, err := c.streamContent(ctx, model, prompt, onChunk)
+	if err != nil {
+		return "", err
+	}
+
+	return c.truncateSummary(summary), nil
+}
+
+// streamContent drives GenerateContentStream to completion, accumulating
+// each delta into the running summary and reporting it to onChunk.
+func (c *Client) streamContent(
+	ctx context.Context,
+	model GenerativeModel,
+	prompt string,
+	onChunk func(text string) error,
+) (string, error) {
+	iter := model.GenerateContentStream(ctx, genai.Text(prompt))
+
+	var builder strings.Builder
+	for {
+		resp, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to stream content: %w", err)
+		}
+
+		// Deltas are kept untrimmed so whitespace between them survives;
+		// only the assembled summary is trimmed below.
+		var chunk strings.Builder
+		for _, candidate := range resp.Candidates {
+			if candidate.Content == nil {
+				continue
+			}
+			for _, part := range candidate.Content.Parts {
+				if text, ok := part.(genai.Text); ok {
+					chunk.WriteString(string(text))
+				}
+			}
+		}
+		if chunk.Len() == 0 {
+			continue
+		}
+
+		builder.WriteString(chunk.String())
+
+		if onChunk != nil {
+			if err := onChunk(builder.String()); err != nil {
+				return "", fmt.Errorf("failed to handle stream chunk: %w", err)
+			}
+		}
+	}
+
+	summary := strings.TrimSpace(builder.String())
+	if summary == "" {
+		return "", fmt.Errorf("empty response from Gemini")
+	}
+
+	return summary, nil
+}
+
+// buildBudgetedPrompt builds the summarization prompt for events, falling
+// back to a map-reduce pipeline when the full prompt would exceed the
+// model's input token limit.
+func (c *Client) buildBudgetedPrompt(
+	ctx context.Context,
+	model GenerativeModel,
+	events []source.FormattedEvent,
+) (string, error) {
+	prompt, err := c.buildPrompt(events)
+	if err != nil {
+		return "", err
+	}
+
+	limit, err := c.inputTokenLimit(ctx, model)
+	if err != nil {
+		return "", err
+	}
+
+	tokens, err := model.CountTokens(ctx, genai.Text(prompt))
+	if err != nil {
+		return "", fmt.Errorf("failed to count tokens: %w", err)
+	}
+
+	if tokens.TotalTokens <= limit {
+		return prompt, nil
+	}
+
+	return c.mapReducePrompt(ctx, model, events, limit)
+}
+
+// inputTokenLimit fetches the model's input token budget, falling back to a
+// conservative default if the model doesn't report one.
+func (c *Client) inputTokenLimit(ctx context.Context, model GenerativeModel) (int32, error) {
+	info, err := model.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch model info: %w", err)
+	}
+
+	if info.InputTokenLimit <= 0 {
+		return defaultInputTokenLimit, nil
+	}
+
+	return info.InputTokenLimit, nil
+}
+
+// mapReducePrompt splits events into batches that each fit limit, summarizes
+// every batch independently, then returns a reduce prompt that merges the
+// batch summaries into a single cohesive one.
+func (c *Client) mapReducePrompt(
+	ctx context.Context,
+	model GenerativeModel,
+	events []source.FormattedEvent,
+	limit int32,
+) (string, error) {
+	batches, err := c.chunkEventsToFit(ctx, model, events, limit)
+	if err != nil {
+		return "", err
+	}
+
+	batchSummaries := make([]string, 0, len(batches))
+	for _, batch := range batches {
+		batchPrompt, err := c.buildPrompt(batch)
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := model.GenerateContent(ctx, genai.Text(batchPrompt))
+		if err != nil {
+			return "", fmt.Errorf("failed to summarize batch: %w", err)
+		}
+
+		if batchSummary := c.extractText(resp); batchSummary != "" {
+			batchSummaries = append(batchSummaries, batchSummary)
+		}
+	}
+
+	return c.buildReducePrompt(batchSummaries)
+}
+
+// buildReducePrompt renders reduceSummaryPromptTemplate with the batch
+// summaries and the client's persona/locale settings, so a configured
+// persona or locale isn't lost when a day's events require map-reduce.
+func (c *Client) buildReducePrompt(batchSummaries []string) (string, error) {
+	tmpl, err := template.New("reducePrompt").Parse(reduceSummaryPromptTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse reduce prompt template: %w", err)
+	}
+
+	data := struct {
+		Summaries string
+		Locale    string
+		Persona   string
+	}{
+		Summaries: strings.Join(batchSummaries, "\n\n---\n\n"),
+		Locale:    c.locale,
+		Persona:   c.persona,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render reduce prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// chunkEventsToFit recursively halves events until every batch's prompt
+// counts at or under limit tokens, so each can be summarized on its own.
+func (c *Client) chunkEventsToFit(
+	ctx context.Context,
+	model GenerativeModel,
+	events []source.FormattedEvent,
+	limit int32,
+) ([][]source.FormattedEvent, error) {
+	if len(events) <= 1 {
+		return [][]source.FormattedEvent{events}, nil
+	}
+
+	prompt, err := c.buildPrompt(events)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := model.CountTokens(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tokens: %w", err)
+	}
 
-	return prompt, nil
+	if tokens.TotalTokens <= limit {
+		return [][]source.FormattedEvent{events}, nil
+	}
+
+	mid := len(events) / 2
+
+	left, err := c.chunkEventsToFit(ctx, model, events[:mid], limit)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := c.chunkEventsToFit(ctx, model, events[mid:], limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(left, right...), nil
 }
 
 // extractText extracts the text content from Gemini response
@@ -157,18 +558,5 @@ func (c *Client) extractText(resp *genai.GenerateContentResponse) string {
 
 // truncateSummary ensures the summary fits within Discord's limits
 func (c *Client) truncateSummary(summary string) string {
-	if len(summary) <= maxSummaryChars {
-		return summary
-	}
-
-	// Find the latest period before the limit
-	truncated := summary[:maxSummaryChars]
-	lastPeriod := strings.LastIndex(truncated, ".")
-
-	if lastPeriod > 0 {
-		return summary[:lastPeriod+1]
-	}
-
-	// No period found, truncate at limit
-	return truncated
+	return summarizer.TruncateSummary(summary, maxSummaryChars)
 }
@@ -7,39 +7,62 @@ import (
 	"testing"
 	"time"
 
-	"github.com/carvalhocaio/routines-in-the-night/internal/github"
+	"github.com/carvalhocaio/routines-in-the-night/internal/gemini/geminifakes"
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
 	"github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/iterator"
 )
 
-// Mock implementations for testing
-
-type mockGenerativeModel struct {
-	response *genai.GenerateContentResponse
-	err      error
-}
-
-func (m *mockGenerativeModel) GenerateContent(
-	_ context.Context,
-	_ ...genai.Part,
-) (*genai.GenerateContentResponse, error) {
-	return m.response, m.err
+// mockContentIterator backs FakeGenerativeModel.GenerateContentStreamReturns
+// in streaming tests; ContentIterator has no counterfeiter fake of its own
+// since it's only ever consumed, never asserted against.
+type mockContentIterator struct {
+	responses []*genai.GenerateContentResponse
+	err       error
+	idx       int
 }
 
-func (m *mockGenerativeModel) SetTemperature(_ float32) {}
+func (m *mockContentIterator) Next() (*genai.GenerateContentResponse, error) {
+	if m.idx >= len(m.responses) {
+		if m.err != nil {
+			return nil, m.err
+		}
+		return nil, iterator.Done
+	}
 
-func (m *mockGenerativeModel) SetMaxOutputTokens(_ int32) {}
+	resp := m.responses[m.idx]
+	m.idx++
+	return resp, nil
+}
 
-type mockGenAIClient struct {
-	model    *mockGenerativeModel
-	closeErr error
+func textResponse(text string) *genai.GenerateContentResponse {
+	return &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{
+				Content: &genai.Content{
+					Parts: []genai.Part{genai.Text(text)},
+				},
+			},
+		},
+	}
 }
 
-func (m *mockGenAIClient) GenerativeModel(_ string) GenerativeModel {
-	return m.model
+// fakeModelWithInfo returns a FakeGenerativeModel whose Info call reports
+// the default input token limit, the shape every non-budget-focused test
+// needs so buildBudgetedPrompt skips map-reduce chunking.
+func fakeModelWithInfo() *geminifakes.FakeGenerativeModel {
+	model := &geminifakes.FakeGenerativeModel{}
+	model.InfoReturns(&genai.ModelInfo{InputTokenLimit: defaultInputTokenLimit}, nil)
+	model.CountTokensReturns(&genai.CountTokensResponse{TotalTokens: 100}, nil)
+	return model
 }
 
-func (m *mockGenAIClient) Close() error {
-	return m.closeErr
+// fakeClientWith wraps model in a FakeGenAIClient, the shape every
+// NewClientWithFactory-based test needs.
+func fakeClientWith(model GenerativeModel) *geminifakes.FakeGenAIClient {
+	client := &geminifakes.FakeGenAIClient{}
+	client.GenerativeModelReturns(model)
+	return client
 }
 
 func TestNewClient(t *testing.T) {
@@ -111,7 +134,7 @@ func TestTruncateSummary(t *testing.T) {
 func TestGenerateDailySummary_EmptyEvents(t *testing.T) {
 	client := NewClient("test-key", "gemini-2.5-flash")
 
-	summary, err := client.GenerateDailySummary([]github.FormattedEvent{})
+	summary, err := client.GenerateDailySummary([]source.FormattedEvent{})
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
@@ -125,11 +148,11 @@ func TestGenerateDailySummary_EmptyEvents(t *testing.T) {
 func TestBuildPrompt(t *testing.T) {
 	client := NewClient("test-key", "gemini-2.5-flash")
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
 			Type:           "PushEvent",
 			Repo:           "user/repo",
-			CreatedAt:      time.Now(),
+			CreateAt:       time.Now(),
 			IsPrivate:      false,
 			Branch:         "main",
 			Commits:        2,
@@ -138,7 +161,7 @@ func TestBuildPrompt(t *testing.T) {
 		{
 			Type:      "CreateEvent",
 			Repo:      "user/repo2",
-			CreatedAt: time.Now(),
+			CreateAt:  time.Now(),
 			IsPrivate: true,
 			RefType:   "branch",
 			Ref:       "feature/new",
@@ -171,11 +194,11 @@ func TestBuildPrompt(t *testing.T) {
 func TestBuildPrompt_SingleEvent(t *testing.T) {
 	client := NewClient("test-key", "gemini-2.5-flash")
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
 			Type:      "IssuesEvent",
 			Repo:      "user/repo",
-			CreatedAt: time.Now(),
+			CreateAt:  time.Now(),
 			IsPrivate: false,
 			Action:    "opened",
 		},
@@ -325,41 +348,41 @@ func TestBuildPrompt_AllEventTypes(t *testing.T) {
 	client := NewClient("test-key", "gemini-2.5-flash")
 
 	now := time.Now()
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
 			Type:           "PushEvent",
 			Repo:           "user/repo",
-			CreatedAt:      now,
+			CreateAt:       now,
 			Branch:         "main",
 			Commits:        1,
 			CommitMessages: []string{"commit"},
 		},
 		{
-			Type:      "CreateEvent",
-			Repo:      "user/repo",
-			CreatedAt: now,
-			RefType:   "branch",
-			Ref:       "new-branch",
+			Type:     "CreateEvent",
+			Repo:     "user/repo",
+			CreateAt: now,
+			RefType:  "branch",
+			Ref:      "new-branch",
 		},
 		{
-			Type:      "DeleteEvent",
-			Repo:      "user/repo",
-			CreatedAt: now,
-			RefType:   "branch",
-			Ref:       "old-branch",
+			Type:     "DeleteEvent",
+			Repo:     "user/repo",
+			CreateAt: now,
+			RefType:  "branch",
+			Ref:      "old-branch",
 		},
 		{
-			Type:      "IssuesEvent",
-			Repo:      "user/repo",
-			CreatedAt: now,
-			Action:    "opened",
+			Type:     "IssuesEvent",
+			Repo:     "user/repo",
+			CreateAt: now,
+			Action:   "opened",
 		},
 		{
-			Type:      "PullRequestEvent",
-			Repo:      "user/repo",
-			CreatedAt: now,
-			Action:    "merged",
-			PRTitle:   "Feature PR",
+			Type:     "PullRequestEvent",
+			Repo:     "user/repo",
+			CreateAt: now,
+			Action:   "merged",
+			PRTitle:  "Feature PR",
 		},
 	}
 
@@ -390,25 +413,10 @@ func minInt(a, b int) int {
 }
 
 func TestGenerateDailySummary_Success(t *testing.T) {
-	mockModel := &mockGenerativeModel{
-		response: &genai.GenerateContentResponse{
-			Candidates: []*genai.Candidate{
-				{
-					Content: &genai.Content{
-						Parts: []genai.Part{
-							genai.Text("This is a generated summary."),
-						},
-					},
-				},
-			},
-		},
-		err: nil,
-	}
+	model := fakeModelWithInfo()
+	model.GenerateContentReturns(textResponse("This is a generated summary."), nil)
 
-	mockClient := &mockGenAIClient{
-		model:    mockModel,
-		closeErr: nil,
-	}
+	mockClient := fakeClientWith(model)
 
 	factory := func(_ context.Context, _ string) (GenAIClient, error) {
 		return mockClient, nil
@@ -416,13 +424,13 @@ func TestGenerateDailySummary_Success(t *testing.T) {
 
 	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
-			Type:      "PushEvent",
-			Repo:      "user/repo",
-			CreatedAt: time.Now(),
-			Branch:    "main",
-			Commits:   1,
+			Type:     "PushEvent",
+			Repo:     "user/repo",
+			CreateAt: time.Now(),
+			Branch:   "main",
+			Commits:  1,
 		},
 	}
 
@@ -435,6 +443,9 @@ func TestGenerateDailySummary_Success(t *testing.T) {
 	if summary != expected {
 		t.Errorf("Expected summary %q, got %q", expected, summary)
 	}
+	if model.GenerateContentCallCount() != 1 {
+		t.Errorf("Expected GenerateContent called once, got: %d", model.GenerateContentCallCount())
+	}
 }
 
 func TestGenerateDailySummary_ClientFactoryError(t *testing.T) {
@@ -444,11 +455,11 @@ func TestGenerateDailySummary_ClientFactoryError(t *testing.T) {
 
 	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
-			Type:      "PushEvent",
-			Repo:      "user/repo",
-			CreatedAt: time.Now(),
+			Type:     "PushEvent",
+			Repo:     "user/repo",
+			CreateAt: time.Now(),
 		},
 	}
 
@@ -463,15 +474,10 @@ func TestGenerateDailySummary_ClientFactoryError(t *testing.T) {
 }
 
 func TestGenerateDailySummary_GenerateContentError(t *testing.T) {
-	mockModel := &mockGenerativeModel{
-		response: nil,
-		err:      errors.New("API error"),
-	}
+	model := fakeModelWithInfo()
+	model.GenerateContentReturns(nil, errors.New("API error"))
 
-	mockClient := &mockGenAIClient{
-		model:    mockModel,
-		closeErr: nil,
-	}
+	mockClient := fakeClientWith(model)
 
 	factory := func(_ context.Context, _ string) (GenAIClient, error) {
 		return mockClient, nil
@@ -479,11 +485,11 @@ func TestGenerateDailySummary_GenerateContentError(t *testing.T) {
 
 	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
-			Type:      "PushEvent",
-			Repo:      "user/repo",
-			CreatedAt: time.Now(),
+			Type:     "PushEvent",
+			Repo:     "user/repo",
+			CreateAt: time.Now(),
 		},
 	}
 
@@ -498,17 +504,10 @@ func TestGenerateDailySummary_GenerateContentError(t *testing.T) {
 }
 
 func TestGenerateDailySummary_EmptyResponse(t *testing.T) {
-	mockModel := &mockGenerativeModel{
-		response: &genai.GenerateContentResponse{
-			Candidates: []*genai.Candidate{},
-		},
-		err: nil,
-	}
+	model := fakeModelWithInfo()
+	model.GenerateContentReturns(&genai.GenerateContentResponse{Candidates: []*genai.Candidate{}}, nil)
 
-	mockClient := &mockGenAIClient{
-		model:    mockModel,
-		closeErr: nil,
-	}
+	mockClient := fakeClientWith(model)
 
 	factory := func(_ context.Context, _ string) (GenAIClient, error) {
 		return mockClient, nil
@@ -516,11 +515,11 @@ func TestGenerateDailySummary_EmptyResponse(t *testing.T) {
 
 	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
-			Type:      "PushEvent",
-			Repo:      "user/repo",
-			CreatedAt: time.Now(),
+			Type:     "PushEvent",
+			Repo:     "user/repo",
+			CreateAt: time.Now(),
 		},
 	}
 
@@ -538,25 +537,10 @@ func TestGenerateDailySummary_TruncatesLongResponse(t *testing.T) {
 	// Create a response that exceeds maxSummaryChars
 	longText := strings.Repeat("a", maxSummaryChars-10) + ". " + strings.Repeat("b", 100)
 
-	mockModel := &mockGenerativeModel{
-		response: &genai.GenerateContentResponse{
-			Candidates: []*genai.Candidate{
-				{
-					Content: &genai.Content{
-						Parts: []genai.Part{
-							genai.Text(longText),
-						},
-					},
-				},
-			},
-		},
-		err: nil,
-	}
+	model := fakeModelWithInfo()
+	model.GenerateContentReturns(textResponse(longText), nil)
 
-	mockClient := &mockGenAIClient{
-		model:    mockModel,
-		closeErr: nil,
-	}
+	mockClient := fakeClientWith(model)
 
 	factory := func(_ context.Context, _ string) (GenAIClient, error) {
 		return mockClient, nil
@@ -564,11 +548,11 @@ func TestGenerateDailySummary_TruncatesLongResponse(t *testing.T) {
 
 	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
 
-	events := []github.FormattedEvent{
+	events := []source.FormattedEvent{
 		{
-			Type:      "PushEvent",
-			Repo:      "user/repo",
-			CreatedAt: time.Now(),
+			Type:     "PushEvent",
+			Repo:     "user/repo",
+			CreateAt: time.Now(),
 		},
 	}
 
@@ -614,3 +598,336 @@ func TestNewClientWithFactory(t *testing.T) {
 		t.Error("Expected custom factory to be called")
 	}
 }
+
+func TestGenerateDailySummaryStream_EmptyEvents(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+
+	var chunks []string
+	summary, err := client.GenerateDailySummaryStream(
+		context.Background(),
+		[]source.FormattedEvent{},
+		func(text string) error {
+			chunks = append(chunks, text)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "Hoje foi um dia de planejamento e reflexão no código."
+	if summary != expected {
+		t.Errorf("Expected default message, got: %s", summary)
+	}
+	if len(chunks) != 1 || chunks[0] != expected {
+		t.Errorf("Expected onChunk called once with default message, got: %v", chunks)
+	}
+}
+
+func TestGenerateDailySummaryStream_Success(t *testing.T) {
+	model := fakeModelWithInfo()
+	model.GenerateContentStreamReturns(&mockContentIterator{
+		responses: []*genai.GenerateContentResponse{
+			textResponse("Parte 1. "),
+			textResponse("Parte 2."),
+		},
+	})
+
+	mockClient := fakeClientWith(model)
+
+	factory := func(_ context.Context, _ string) (GenAIClient, error) {
+		return mockClient, nil
+	}
+
+	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now(), Commits: 1},
+	}
+
+	var chunks []string
+	summary, err := client.GenerateDailySummaryStream(
+		context.Background(),
+		events,
+		func(text string) error {
+			chunks = append(chunks, text)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "Parte 1. Parte 2."
+	if summary != expected {
+		t.Errorf("Expected summary %q, got %q", expected, summary)
+	}
+	if len(chunks) != 2 {
+		t.Fatalf("Expected 2 progressive chunks, got: %d", len(chunks))
+	}
+	if chunks[0] != "Parte 1. " || chunks[1] != expected {
+		t.Errorf("Expected chunks to accumulate progressively, got: %v", chunks)
+	}
+	if model.GenerateContentStreamCallCount() != 1 {
+		t.Errorf("Expected GenerateContentStream called once, got: %d", model.GenerateContentStreamCallCount())
+	}
+}
+
+func TestGenerateDailySummaryStream_StreamError(t *testing.T) {
+	model := fakeModelWithInfo()
+	model.GenerateContentStreamReturns(&mockContentIterator{err: errors.New("stream broke")})
+
+	mockClient := fakeClientWith(model)
+
+	factory := func(_ context.Context, _ string) (GenAIClient, error) {
+		return mockClient, nil
+	}
+
+	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
+
+	events := []source.FormattedEvent{{Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now()}}
+
+	_, err := client.GenerateDailySummaryStream(context.Background(), events, nil)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to stream content") {
+		t.Errorf("Expected error to contain 'failed to stream content', got: %v", err)
+	}
+}
+
+func TestGenerateDailySummaryStream_OnChunkError(t *testing.T) {
+	model := fakeModelWithInfo()
+	model.GenerateContentStreamReturns(&mockContentIterator{
+		responses: []*genai.GenerateContentResponse{textResponse("Parte 1.")},
+	})
+
+	mockClient := fakeClientWith(model)
+
+	factory := func(_ context.Context, _ string) (GenAIClient, error) {
+		return mockClient, nil
+	}
+
+	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
+
+	events := []source.FormattedEvent{{Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now()}}
+
+	_, err := client.GenerateDailySummaryStream(
+		context.Background(),
+		events,
+		func(_ string) error { return errors.New("discord edit failed") },
+	)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to handle stream chunk") {
+		t.Errorf("Expected error to contain 'failed to handle stream chunk', got: %v", err)
+	}
+}
+
+func TestGenerateDailySummaryStream_MapReduceWhenOverBudget(t *testing.T) {
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "user/repo1", CreateAt: time.Now(), Commits: 1},
+		{Type: "PushEvent", Repo: "user/repo2", CreateAt: time.Now(), Commits: 1},
+	}
+
+	batch0, err := (&Client{}).buildPrompt(events[:1])
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	batch1, err := (&Client{}).buildPrompt(events[1:])
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	model := &geminifakes.FakeGenerativeModel{}
+	model.InfoReturns(&genai.ModelInfo{InputTokenLimit: 10}, nil)
+	model.CountTokensStub = func(_ context.Context, parts ...genai.Part) (*genai.CountTokensResponse, error) {
+		if text, ok := parts[0].(genai.Text); ok {
+			switch string(text) {
+			case batch0, batch1:
+				return &genai.CountTokensResponse{TotalTokens: 5}, nil
+			}
+		}
+		// anything not keyed above (i.e. the full, combined prompt) is over budget
+		return &genai.CountTokensResponse{TotalTokens: 100}, nil
+	}
+	model.GenerateContentReturns(textResponse("Resumo combinado."), nil)
+	model.GenerateContentStreamReturns(&mockContentIterator{
+		responses: []*genai.GenerateContentResponse{textResponse("Resumo combinado.")},
+	})
+
+	mockClient := fakeClientWith(model)
+
+	factory := func(_ context.Context, _ string) (GenAIClient, error) {
+		return mockClient, nil
+	}
+
+	client := NewClientWithFactory("test-key", "gemini-2.5-flash", factory)
+
+	summary, err := client.GenerateDailySummaryStream(context.Background(), events, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if summary != "Resumo combinado." {
+		t.Errorf("Expected reduced summary, got: %q", summary)
+	}
+	if model.GenerateContentCallCount() != 2 {
+		t.Errorf("Expected GenerateContent called once per batch (2), got: %d", model.GenerateContentCallCount())
+	}
+}
+
+func TestInputTokenLimit_FallsBackWhenUnreported(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	model := &geminifakes.FakeGenerativeModel{}
+	model.InfoReturns(&genai.ModelInfo{InputTokenLimit: 0}, nil)
+
+	limit, err := client.inputTokenLimit(context.Background(), model)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if limit != defaultInputTokenLimit {
+		t.Errorf("Expected fallback limit %d, got: %d", defaultInputTokenLimit, limit)
+	}
+}
+
+func TestInputTokenLimit_ModelInfoError(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	model := &geminifakes.FakeGenerativeModel{}
+	model.InfoReturns(nil, errors.New("info unavailable"))
+
+	_, err := client.inputTokenLimit(context.Background(), model)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to fetch model info") {
+		t.Errorf("Expected error to contain 'failed to fetch model info', got: %v", err)
+	}
+}
+
+func TestChunkEventsToFit_SplitsUntilWithinBudget(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "user/repo1", CreateAt: time.Now()},
+		{Type: "PushEvent", Repo: "user/repo2", CreateAt: time.Now()},
+		{Type: "PushEvent", Repo: "user/repo3", CreateAt: time.Now()},
+	}
+
+	model := &geminifakes.FakeGenerativeModel{}
+	model.CountTokensReturns(&genai.CountTokensResponse{TotalTokens: 1}, nil) // every batch "fits" immediately
+
+	batches, err := client.chunkEventsToFit(context.Background(), model, events, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(batches) != 1 {
+		t.Fatalf("Expected events to stay as a single batch when already within budget, got: %d", len(batches))
+	}
+
+	model = &geminifakes.FakeGenerativeModel{}
+	model.CountTokensReturns(&genai.CountTokensResponse{TotalTokens: 100}, nil) // every batch, however small, is "over budget"
+	batches, err = client.chunkEventsToFit(context.Background(), model, events, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var total int
+	for _, batch := range batches {
+		total += len(batch)
+		if len(batch) != 1 {
+			t.Errorf("Expected recursion to halve down to single-event batches, got batch of size %d", len(batch))
+		}
+	}
+	if total != len(events) {
+		t.Errorf("Expected batches to cover all %d events, got %d", len(events), total)
+	}
+}
+
+func TestBuildPrompt_UsesDefaultTemplateFields(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	client.SetUser("octocat")
+	client.SetLocale("pt-BR")
+	client.SetPersona("formal")
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now()},
+	}
+
+	prompt, err := client.buildPrompt(events)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(prompt, "octocat") {
+		t.Error("Prompt should contain the configured user")
+	}
+	if !strings.Contains(prompt, "pt-BR") {
+		t.Error("Prompt should contain the configured locale")
+	}
+	if !strings.Contains(prompt, "formal") {
+		t.Error("Prompt should contain the configured persona")
+	}
+}
+
+func TestBuildPrompt_WithPromptOverridesTemplate(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	client.WithPrompt("Custom prompt for {{.User}}: {{.Events}}")
+	client.SetUser("octocat")
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now()},
+	}
+
+	prompt, err := client.buildPrompt(events)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.HasPrefix(prompt, "Custom prompt for octocat:") {
+		t.Errorf("Expected custom template to be rendered, got: %q", prompt)
+	}
+	if !strings.Contains(prompt, "user/repo") {
+		t.Error("Prompt should still contain the marshaled events")
+	}
+}
+
+func TestBuildPrompt_InvalidTemplateReturnsError(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	client.WithPrompt("{{.Events")
+
+	_, err := client.buildPrompt(nil)
+	if err == nil {
+		t.Fatal("Expected an error for a malformed template, got nil")
+	}
+}
+
+func TestWithPrompt_ReturnsClientForChaining(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+
+	if got := client.WithPrompt("{{.Events}}"); got != client {
+		t.Error("Expected WithPrompt to return the same client for chaining")
+	}
+}
+
+func TestBuildReducePrompt_CarriesPersonaAndLocale(t *testing.T) {
+	client := NewClient("test-key", "gemini-2.5-flash")
+	client.SetPersona("formal")
+	client.SetLocale("pt-BR")
+
+	prompt, err := client.buildReducePrompt([]string{"Resumo do lote 1", "Resumo do lote 2"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(prompt, "formal") {
+		t.Error("Reduce prompt should carry the configured persona")
+	}
+	if !strings.Contains(prompt, "pt-BR") {
+		t.Error("Reduce prompt should carry the configured locale")
+	}
+	if !strings.Contains(prompt, "Resumo do lote 1") || !strings.Contains(prompt, "Resumo do lote 2") {
+		t.Error("Reduce prompt should contain every batch summary")
+	}
+}
@@ -1,20 +1,24 @@
 package gemini
 
-const dailySummaryPromptTemplate = `Você é um assistente que recebe as atividades
-feitas no GitHub hoje, incluindo ações em repositórios privados. Com base
-nelas, gere um resumo detalhado em formato de parágrafo:
+// reduceSummaryPromptTemplate merges independently generated batch summaries
+// into a single cohesive daily summary. Used by the map-reduce pipeline in
+// GenerateDailySummaryStream when a day's events don't fit in one prompt.
+// Rendered with the same {{.Persona}} and {{.Locale}} fields as the
+// per-batch prompt so a custom persona/locale survives map-reduce.
+const reduceSummaryPromptTemplate = `Você é um assistente{{if .Persona}}, adotando a seguinte persona: {{.Persona}},{{end}} que recebe vários
+resumos parciais das atividades feitas no GitHub hoje, cada um cobrindo um
+grupo diferente de eventos do mesmo dia. Combine-os em um único resumo
+coeso em formato de parágrafo:
 
 REQUISITOS:
 - Texto em parágrafo corrido, com pelo menos 100-150 palavras
 - Sem emojis e sem hashtags
-- Seja específico sobre cada atividade realizada
-- Mencione nomes dos repositórios, branches, e detalhes técnicos quando relevante
-- Descreva o contexto e propósito das mudanças quando possível
-- Use linguagem técnica mas acessível
-- Evite frases genéricas como "dia produtivo" ou "muito trabalho"
-- Conecte as atividades em uma narrativa coesa sobre o trabalho do dia
+- Elimine repetições entre os resumos parciais
+- Preserve os detalhes técnicos específicos de cada um (repositórios, branches, etc.)
+- Conecte tudo em uma narrativa coesa sobre o trabalho do dia
+{{if .Locale}}- Escreva a resposta no idioma/região: {{.Locale}}
+{{end}}
+Resumos parciais:
+{{.Summaries}}
 
-Atividades do dia:
-%s
-
-Gere um texto detalhado e informativo sobre essas atividades de desenvolvimento.` //nolint:misspell // "informativo" is correct in Portuguese
+Gere um único texto detalhado e informativo combinando essas atividades.` //nolint:misspell // "informativo" is correct in Portuguese
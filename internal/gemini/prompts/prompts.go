@@ -0,0 +1,22 @@
+// Package prompts ships the built-in daily-summary prompt templates for
+// gemini.Client, embedded into the binary via go:embed so the default
+// behavior doesn't depend on an external file while still being easy to
+// copy into a custom PROMPT_TEMPLATE_PATH file.
+package prompts
+
+import _ "embed"
+
+// Default is the built-in daily-summary template, rendered with Events,
+// User, Date, Locale, and Persona. Used when PROMPT_TEMPLATE_PATH and
+// Client.WithPrompt are both unset, preserving the reporter's original
+// Portuguese summary style.
+//
+//go:embed default.tmpl
+var Default string
+
+// Haiku is an example persona template shipped as a starting point for a
+// custom PROMPT_TEMPLATE_PATH, rendering the day's activities as haiku
+// instead of a paragraph summary.
+//
+//go:embed haiku.tmpl
+var Haiku string
@@ -0,0 +1,184 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// appTokenSource mints and caches GitHub App installation tokens. A
+// short-lived JWT signed with the app's private key is exchanged for an
+// installation token, which is refreshed shortly before it expires so a long
+// running process never has to restart to pick up a new one.
+type appTokenSource struct {
+	appID          int64
+	installationID int64
+	privateKey     *rsa.PrivateKey
+	baseURL        string
+	httpClient     *http.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// newAppTokenSource parses a PEM-encoded RSA private key and returns a
+// appTokenSource that exchanges it for installation tokens against baseURL.
+func newAppTokenSource(appID, installationID int64, privateKeyPEM []byte, baseURL string) (*appTokenSource, error) {
+	key, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse app private key: %w", err)
+	}
+
+	return &appTokenSource{
+		appID:          appID,
+		installationID: installationID,
+		privateKey:     key,
+		baseURL:        baseURL,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+func parsePrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported private key format: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// Token returns a valid installation token, refreshing it if it is missing
+// or within a minute of expiring.
+func (a *appTokenSource) Token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Before(a.expiresAt) {
+		return a.token, nil
+	}
+
+	jwt, err := a.signJWT()
+	if err != nil {
+		return "", fmt.Errorf("failed to sign app JWT: %w", err)
+	}
+
+	token, expiresAt, err := a.exchangeInstallationToken(jwt)
+	if err != nil {
+		return "", err
+	}
+
+	a.token = token
+	a.expiresAt = expiresAt.Add(-1 * time.Minute)
+
+	return a.token, nil
+}
+
+// signJWT builds and signs a short-lived RS256 JWT identifying the app, as
+// required by GitHub to mint installation tokens.
+func (a *appTokenSource) signJWT() (string, error) {
+	now := time.Now()
+
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	claims := map[string]any{
+		"iat": now.Add(-1 * time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(a.appID, 10),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT claims: %w", err)
+	}
+
+	signingInput := base64URLEncode(headerJSON) + "." + base64URLEncode(claimsJSON)
+
+	signature, err := signRS256(a.privateKey, signingInput)
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func signRS256(key *rsa.PrivateKey, signingInput string) ([]byte, error) {
+	sum := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return signature, nil
+}
+
+func base64URLEncode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// exchangeInstallationToken exchanges a signed app JWT for an installation
+// access token via POST /app/installations/{id}/access_tokens.
+func (a *appTokenSource) exchangeInstallationToken(jwt string) (string, time.Time, error) {
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", a.baseURL, a.installationID)
+
+	req, err := http.NewRequest(http.MethodPost, url, http.NoBody)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", jwt))
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("X-GitHub-Api-Version", githubAPIVERSION)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // defer close is best effort
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", time.Time{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return body.Token, body.ExpiresAt, nil
+}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func generateTestPrivateKeyPEM(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+}
+
+func TestNewAppClientWithBaseURL(t *testing.T) {
+	privateKeyPEM := generateTestPrivateKeyPEM(t)
+
+	client, err := NewAppClientWithBaseURL("testuser", 1, 2, privateKeyPEM, "http://custom.api")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if client.username != "testuser" {
+		t.Errorf("Expected username=testuser, got: %s", client.username)
+	}
+	if client.appAuth == nil {
+		t.Fatal("Expected appAuth to be set")
+	}
+}
+
+func TestNewAppClientWithBaseURL_InvalidKey(t *testing.T) {
+	_, err := NewAppClientWithBaseURL("testuser", 1, 2, []byte("not a pem"), "http://custom.api")
+	if err == nil {
+		t.Fatal("Expected error for invalid private key")
+	}
+	if !strings.Contains(err.Error(), "failed to parse app private key") {
+		t.Errorf("Expected parse error, got: %v", err)
+	}
+}
+
+func TestAppTokenSource_Token_ExchangesAndCaches(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/app/installations/2/access_tokens") {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			t.Errorf("Expected Bearer JWT, got: %s", r.Header.Get("Authorization"))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      "installation-token",
+			"expires_at": time.Now().Add(1 * time.Hour),
+		})
+	}))
+	defer server.Close()
+
+	auth, err := newAppTokenSource(1, 2, generateTestPrivateKeyPEM(t), server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	token, err := auth.Token()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if token != "installation-token" {
+		t.Errorf("Expected installation-token, got: %s", token)
+	}
+
+	if _, err := auth.Token(); err != nil {
+		t.Fatalf("Expected no error on cached call, got: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 exchange request, got: %d", requests)
+	}
+}
+
+func TestAppTokenSource_Token_RefreshesNearExpiry(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      "installation-token",
+			"expires_at": time.Now().Add(30 * time.Second),
+		})
+	}))
+	defer server.Close()
+
+	auth, err := newAppTokenSource(1, 2, generateTestPrivateKeyPEM(t), server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, err := auth.Token(); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := auth.Token(); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected a refresh because the cached token was within a minute of expiry, got %d requests", requests)
+	}
+}
+
+func TestAppTokenSource_Token_ExchangeFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	auth, err := newAppTokenSource(1, 2, generateTestPrivateKeyPEM(t), server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, err := auth.Token(); err == nil {
+		t.Fatal("Expected error from failed exchange")
+	}
+}
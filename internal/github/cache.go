@@ -0,0 +1,61 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// cacheEntry holds the conditional-request validators returned for a
+// single URL on a previous fetch, so the next run can ask GitHub for only
+// what changed via If-None-Match/If-Modified-Since.
+type cacheEntry struct {
+	ETag         string `json:"etag"`
+	LastModified string `json:"last_modified"`
+}
+
+// eventCache maps a request URL to its cached conditional-request
+// validators. Keying by URL (rather than just username) lets a future
+// caller cache more than just the first page.
+type eventCache map[string]cacheEntry
+
+// cacheFilePath returns a per-client cache file under the OS temp
+// directory, keyed by base URL and username so different accounts (or
+// test servers) never collide.
+func (c *Client) cacheFilePath() string {
+	key := sha256.Sum256([]byte(c.baseURL + "/" + c.username))
+	fileName := "routines-in-the-night-github-" + hex.EncodeToString(key[:8]) + ".json"
+	return filepath.Join(os.TempDir(), fileName)
+}
+
+// loadCache reads the cached conditional-request validators, returning an
+// empty cache if none exists yet or the file can't be read.
+func (c *Client) loadCache() eventCache {
+	cache := eventCache{}
+
+	data, err := os.ReadFile(c.cacheFilePath())
+	if err != nil {
+		return cache
+	}
+
+	_ = json.Unmarshal(data, &cache) //nolint:errcheck // a corrupt cache just means we refetch
+
+	return cache
+}
+
+// saveCacheEntry persists the conditional-request validators for url for
+// the next run. Failures are ignored: caching is a best-effort
+// optimization, not a correctness requirement.
+func (c *Client) saveCacheEntry(url string, entry cacheEntry) {
+	cache := c.loadCache()
+	cache[url] = entry
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	_ = os.WriteFile(c.cacheFilePath(), data, 0o600) //nolint:errcheck // best-effort cache write
+}
@@ -1,26 +1,52 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/store"
 )
 
 const (
 	githubAPIURL     = "https://api.github.com"
 	githubAPIVERSION = "2022-11-28"
+
+	defaultMaxRetries = 3
+	retryBaseDelay    = 20 * time.Millisecond
+	retryMaxDelay     = 200 * time.Millisecond
 )
 
 // Client handles GitHub API interactions
 type Client struct {
 	username   string
 	token      string
+	appAuth    *appTokenSource
+	baseURL    string
 	httpClient *http.Client
+	maxRetries int
+	store      store.Store
+
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimit
+}
+
+// RateLimit is the most recently observed GitHub API rate-limit state, as
+// reported by the X-RateLimit-* response headers.
+type RateLimit struct {
+	Remaining int
+	Reset     time.Time
 }
 
 // Event represents a GitHub event
 type Event struct {
+	ID        string    `json:"id"`
 	Type      string    `json:"type"`
 	Repo      Repo      `json:"repo"`
 	CreatedAt time.Time `json:"created_at"`
@@ -53,95 +79,362 @@ type PullRequest struct {
 	Title string `json:"title"`
 }
 
-// FormattedEvent contains processes event information
-type FormattedEvent struct {
-	Type           string    `json:"type"`
-	Repo           string    `json:"repo"`
-	CreateAt       time.Time `json:"create_at"`
-	IsPrivate      bool      `json:"is_private"`
-	Branch         string    `json:"branch,omitempty"`
-	Commits        int       `json:"commits,omitempty"`
-	CommitMessages []string  `json:"commit_messages,omitempty"`
-	RefType        string    `json:"ref_type,omitempty"`
-	Ref            string    `json:"ref,omitempty"`
-	Action         string    `json:"action,omitempty"`
-	PRTitle        string    `json:"pr_title,omitempty"`
-}
-
 // NewClient creates a new GitHub API client
 func NewClient(username, token string) *Client {
+	return NewClientWithBaseURL(username, token, githubAPIURL)
+}
+
+// NewClientWithBaseURL creates a new GitHub API client pointed at a custom
+// base URL (used in tests to point at an httptest.Server).
+func NewClientWithBaseURL(username, token, baseURL string) *Client {
+	return &Client{
+		username:   username,
+		token:      token,
+		baseURL:    baseURL,
+		maxRetries: defaultMaxRetries,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// NewAppClient creates a GitHub API client authenticated as an app
+// installation rather than a personal access token. It mints short-lived
+// JWTs signed with privateKeyPEM and exchanges them for installation
+// tokens on demand, which removes the per-user rate-limit cap and lets an
+// org share one app instead of a human's PAT.
+func NewAppClient(username string, appID, installationID int64, privateKeyPEM []byte) (*Client, error) {
+	return NewAppClientWithBaseURL(username, appID, installationID, privateKeyPEM, githubAPIURL)
+}
+
+// NewAppClientWithBaseURL is NewAppClient with a custom base URL (used in
+// tests to point at an httptest.Server).
+func NewAppClientWithBaseURL(
+	username string, appID, installationID int64, privateKeyPEM []byte, baseURL string,
+) (*Client, error) {
+	auth, err := newAppTokenSource(appID, installationID, privateKeyPEM, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
-		username: username,
-		token:    token,
+		username:   username,
+		appAuth:    auth,
+		baseURL:    baseURL,
+		maxRetries: defaultMaxRetries,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+	}, nil
+}
+
+// SetMaxRetries overrides the number of retry attempts doWithRateLimit
+// makes on 403, 429 and 5xx responses before giving up.
+func (c *Client) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
+// SetStore wires a persistence layer into the client: once set,
+// GetDailyEventsContext fetches since the store's last successful run
+// instead of a fixed 24h lookback, and persists every fetched event via
+// SaveEvents. Callers are responsible for calling store.MarkRun once the
+// rest of the pipeline (e.g. notification) also succeeds.
+func (c *Client) SetStore(s store.Store) {
+	c.store = s
+}
+
+// RateLimit returns the rate-limit state observed on the most recent
+// response.
+func (c *Client) RateLimit() RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	return c.rateLimit
+}
+
+// authHeader returns the value to send as the Authorization header,
+// minting or refreshing an app installation token as needed.
+func (c *Client) authHeader() (string, error) {
+	if c.appAuth != nil {
+		token, err := c.appAuth.Token()
+		if err != nil {
+			return "", fmt.Errorf("failed to obtain installation token: %w", err)
+		}
+		return fmt.Sprintf("Bearer %s", token), nil
 	}
+
+	return fmt.Sprintf("Bearer %s", c.token), nil
+}
+
+// Name identifies this source for event tagging and configuration.
+func (c *Client) Name() string {
+	return "github"
 }
 
-// GetDailyEvents fetches GitHub events from the last 24 hours
-func (c *Client) GetDailyEvents() ([]FormattedEvent, error) {
-	events, err := c.fetchUserEvents()
+// FetchDailyEvents implements source.Source.
+func (c *Client) FetchDailyEvents(ctx context.Context) ([]source.FormattedEvent, error) {
+	return c.GetDailyEventsContext(ctx)
+}
+
+// GetDailyEvents fetches GitHub events since the last successful run (or
+// the last 24 hours), as described on GetDailyEventsContext. It is a
+// wrapper around GetDailyEventsContext using context.Background(); prefer
+// the context-aware version when the caller has a deadline or wants to
+// cancel a slow fetch.
+func (c *Client) GetDailyEvents() ([]source.FormattedEvent, error) {
+	return c.GetDailyEventsContext(context.Background())
+}
+
+// GetDailyEventsContext fetches GitHub events since the last successful
+// run recorded in the store (or the last 24 hours, if no store is
+// configured or it has never run), aborting early if ctx is canceled or
+// its deadline expires. If a store is configured, the fetched events are
+// also persisted via SaveEvents so later runs can dedupe and backfill
+// history regardless of whether the caller's notification succeeds.
+func (c *Client) GetDailyEventsContext(ctx context.Context) ([]source.FormattedEvent, error) {
+	cutoff := c.sinceCutoff()
+
+	events, err := c.fetchUserEvents(ctx, cutoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch events: %w", err)
 	}
 
-	// Filter events from the last 24 hours
-	yesterday := time.Now().Add(-24 * time.Hour)
 	var recentEvents []Event
-
 	for i := range events {
-		if events[i].CreatedAt.After(yesterday) {
+		if events[i].CreatedAt.After(cutoff) {
 			recentEvents = append(recentEvents, events[i])
 		}
 	}
 
-	return c.formatEvents(recentEvents), nil
+	formatted := c.formatEvents(recentEvents)
+
+	if c.store != nil {
+		if err := c.store.SaveEvents(formatted); err != nil {
+			return nil, fmt.Errorf("failed to save events: %w", err)
+		}
+	}
+
+	return formatted, nil
 }
 
-// fetchUserEvents retrieves events from GitHub API
-func (c *Client) fetchUserEvents() ([]Event, error) {
-	url := fmt.Sprintf("%s/users/%s/events", githubAPIURL, c.username)
+// sinceCutoff returns the time to fetch events from: the last successful
+// run recorded in the store, if one is configured and has run before,
+// otherwise a fixed 24h lookback. Windowing off the last successful run
+// instead of a fixed 24h means a missed cron invocation doesn't silently
+// drop events.
+func (c *Client) sinceCutoff() time.Time {
+	fallback := time.Now().Add(-24 * time.Hour)
 
-	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if c.store == nil {
+		return fallback
 	}
 
-	// Set headers
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("X-GitHub-Api-Version", githubAPIVERSION)
+	lastRun, err := c.store.LastRunAt()
+	if err != nil || lastRun.IsZero() {
+		return fallback
+	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+	return lastRun
+}
+
+// fetchUserEvents retrieves events from the GitHub API, walking paginated
+// Link headers until a page's oldest event predates cutoff or there are
+// no more pages. The first page is sent with conditional request headers
+// from a prior run's cache; a 304 response is treated as "no new events"
+// and does not consume the rate-limit budget.
+func (c *Client) fetchUserEvents(ctx context.Context, cutoff time.Time) ([]Event, error) {
+	cache := c.loadCache()
+
+	var allEvents []Event
+	url := fmt.Sprintf("%s/users/%s/events", c.baseURL, c.username)
+	firstPage := true
+
+	for url != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		authHeader, err := c.authHeader()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", authHeader)
+		req.Header.Set("Accept", "application/vnd.github.v3+json")
+		req.Header.Set("X-GitHub-Api-Version", githubAPIVERSION)
+
+		if firstPage {
+			if entry, ok := cache[url]; ok {
+				if entry.ETag != "" {
+					req.Header.Set("If-None-Match", entry.ETag)
+				}
+				if entry.LastModified != "" {
+					req.Header.Set("If-Modified-Since", entry.LastModified)
+				}
+			}
+		}
+
+		resp, err := c.doWithRateLimit(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusNotModified {
+			_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+			return allEvents, nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			status := resp.StatusCode
+			_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+			return nil, fmt.Errorf("unexpected status code: %d", status)
+		}
+
+		if firstPage {
+			c.saveCacheEntry(url, cacheEntry{
+				ETag:         resp.Header.Get("ETag"),
+				LastModified: resp.Header.Get("Last-Modified"),
+			})
+		}
+
+		var pageEvents []Event
+		decodeErr := json.NewDecoder(resp.Body).Decode(&pageEvents)
+		link := resp.Header.Get("Link")
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+
+		if decodeErr != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", decodeErr)
+		}
+
+		allEvents = append(allEvents, pageEvents...)
+
+		if len(pageEvents) == 0 || pageEvents[len(pageEvents)-1].CreatedAt.Before(cutoff) {
+			break
+		}
+
+		url = nextPageURL(link)
+		if url != "" {
+			// A relative next-page reference is resolved against the page just fetched.
+			if next, err := req.URL.Parse(url); err == nil {
+				url = next.String()
+			}
+		}
+		firstPage = false
 	}
-	defer func() {
+
+	return allEvents, nil
+}
+
+// doWithRateLimit executes req, sleeping until the rate-limit window
+// resets when the budget is exhausted, and retrying 403, 429 and 5xx responses
+// up to c.maxRetries times with exponential backoff and jitter, honoring a
+// Retry-After header when the server sends one.
+func (c *Client) doWithRateLimit(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	var resp *http.Response
+
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("request canceled: %w", err)
+		}
+
+		var err error
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, fmt.Errorf("request canceled: %w", ctxErr)
+			}
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		c.recordRateLimit(resp.Header)
+
+		isRetryable := resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode == http.StatusForbidden ||
+			resp.StatusCode >= http.StatusInternalServerError
+		if !isRetryable || attempt >= c.maxRetries {
+			return resp, nil
+		}
+
+		// Only wait out the rate-limit window when another request is
+		// actually about to be issued: a terminal success that happens to
+		// exhaust the budget must still return its events immediately.
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			if reset, ok := rateLimitReset(resp.Header.Get("X-RateLimit-Reset")); ok {
+				if wait := time.Until(reset); wait > 0 {
+					if err := sleepContext(ctx, wait); err != nil {
+						_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+						return nil, fmt.Errorf("request canceled: %w", err)
+					}
+				}
+			}
+		}
+
+		wait, ok := retryAfterDuration(resp.Header.Get("Retry-After"))
+		if !ok {
+			wait = backoffWithJitter(attempt)
+		}
+
 		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
-	}()
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("request canceled: %w", err)
+		}
+		req = req.Clone(ctx)
+	}
+}
+
+// sleepContext waits for d, returning early with ctx.Err() if ctx is
+// canceled or its deadline expires first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// recordRateLimit updates the client's last-observed rate-limit state from
+// response headers.
+func (c *Client) recordRateLimit(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"unexpected status code: %d",
-			resp.StatusCode,
-		)
+	reset, ok := rateLimitReset(header.Get("X-RateLimit-Reset"))
+	if !ok {
+		return
 	}
 
-	var events []Event
-	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	c.rateLimitMu.Lock()
+	c.rateLimit = RateLimit{Remaining: remaining, Reset: reset}
+	c.rateLimitMu.Unlock()
+}
+
+// backoffWithJitter returns an exponentially growing delay for the given
+// retry attempt (0-indexed), capped at retryMaxDelay and jittered by up to
+// 50% to avoid a thundering herd of synchronized retries.
+func backoffWithJitter(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
 	}
 
-	return events, nil
+	jitter := time.Duration(rand.Int63n(int64(delay) / 2)) //nolint:gosec // non-cryptographic jitter
+	return delay/2 + jitter
 }
 
 // formatEvents converts raw events to formatted events
-func (c *Client) formatEvents(events []Event) []FormattedEvent {
-	formatted := make([]FormattedEvent, 0, len(events))
+func (c *Client) formatEvents(events []Event) []source.FormattedEvent {
+	formatted := make([]source.FormattedEvent, 0, len(events))
 
 	for i := range events {
-		fe := FormattedEvent{
+		fe := source.FormattedEvent{
+			ID:        events[i].ID,
+			Source:    c.Name(),
 			Type:      events[i].Type,
 			Repo:      events[i].Repo.Name,
 			CreateAt:  events[i].CreatedAt,
@@ -1,14 +1,64 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
 )
 
+// fakeStore is an in-memory store.Store used to test GetDailyEventsContext's
+// windowing and persistence without depending on a real backend.
+type fakeStore struct {
+	events    map[string]source.FormattedEvent
+	lastRunAt time.Time
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{events: make(map[string]source.FormattedEvent)}
+}
+
+func (s *fakeStore) SaveEvents(events []source.FormattedEvent) error {
+	for _, e := range events {
+		s.events[e.ID] = e
+	}
+	return nil
+}
+
+func (s *fakeStore) SeenEventIDs() (map[string]bool, error) {
+	seen := make(map[string]bool, len(s.events))
+	for id := range s.events {
+		seen[id] = true
+	}
+	return seen, nil
+}
+
+func (s *fakeStore) LastRunAt() (time.Time, error) {
+	return s.lastRunAt, nil
+}
+
+func (s *fakeStore) MarkRun(t time.Time) error {
+	s.lastRunAt = t
+	return nil
+}
+
+func (s *fakeStore) EventsBetween(from, to time.Time) ([]source.FormattedEvent, error) {
+	var events []source.FormattedEvent
+	for _, e := range s.events {
+		if !e.CreateAt.Before(from) && e.CreateAt.Before(to) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
 func TestNewClient(t *testing.T) {
 	username := "testuser"
 	token := "testtoken"
@@ -427,6 +477,124 @@ func TestGetDailyEvents_EmptyResponse(t *testing.T) {
 	}
 }
 
+func TestGetDailyEvents_WithStore_FetchesSinceLastRun(t *testing.T) {
+	now := time.Now()
+	threeDaysAgo := now.Add(-72 * time.Hour)
+
+	events := []Event{
+		{
+			ID:        "1",
+			Type:      "PushEvent",
+			CreatedAt: threeDaysAgo,
+			Repo:      Repo{Name: "user/repo"},
+			Payload:   Payload{Ref: "refs/heads/main", Commits: []Commit{{Message: "old", SHA: "abc"}}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(events); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	st := newFakeStore()
+	st.lastRunAt = now.Add(-96 * time.Hour) // older than the event, within window
+
+	client := NewClientWithBaseURL("testuser", "testtoken", server.URL)
+	client.SetStore(st)
+
+	result, err := client.GetDailyEvents()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("Expected the 3-day-old event to be included via store windowing, got: %d", len(result))
+	}
+}
+
+func TestGetDailyEvents_WithStore_PersistsFetchedEvents(t *testing.T) {
+	events := []Event{
+		{
+			ID:        "42",
+			Type:      "PushEvent",
+			CreatedAt: time.Now(),
+			Repo:      Repo{Name: "user/repo"},
+			Payload:   Payload{Ref: "refs/heads/main", Commits: []Commit{{Message: "test", SHA: "abc"}}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(events); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	st := newFakeStore()
+	client := NewClientWithBaseURL("testuser", "testtoken", server.URL)
+	client.SetStore(st)
+
+	if _, err := client.GetDailyEvents(); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, ok := st.events["42"]; !ok {
+		t.Error("Expected fetched event to be persisted to the store")
+	}
+}
+
+func TestGetDailyEvents_WithStore_RetryAfterFailedNotifyDoesNotDuplicate(t *testing.T) {
+	// Simulates a crash-mid-run: events are fetched and saved, but the
+	// caller's notification fails so MarkRun is never called. The next
+	// run re-fetches the same overlapping window; SaveEvents must be
+	// idempotent so the store ends up with one row per event, not two.
+	events := []Event{
+		{
+			ID:        "1",
+			Type:      "PushEvent",
+			CreatedAt: time.Now(),
+			Repo:      Repo{Name: "user/repo"},
+			Payload:   Payload{Ref: "refs/heads/main", Commits: []Commit{{Message: "test", SHA: "abc"}}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(events); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	st := newFakeStore()
+	client := NewClientWithBaseURL("testuser", "testtoken", server.URL)
+	client.SetStore(st)
+
+	// First run: fetch succeeds, but the caller's notify fails so MarkRun
+	// is never invoked (left to the caller, not GetDailyEvents).
+	firstResult, err := client.GetDailyEvents()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Retry: same window is fetched again since LastRunAt is unchanged.
+	secondResult, err := client.GetDailyEvents()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(firstResult) != 1 || len(secondResult) != 1 {
+		t.Fatalf("Expected both runs to return the event for resending, got %d and %d",
+			len(firstResult), len(secondResult))
+	}
+	if len(st.events) != 1 {
+		t.Errorf("Expected exactly 1 stored event after retry, got: %d", len(st.events))
+	}
+}
+
 func TestFormatEvents_EmptySlice(t *testing.T) {
 	client := NewClient("testuser", "testtoken")
 
@@ -491,3 +659,281 @@ func TestEventTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestFetchUserEvents_Pagination(t *testing.T) {
+	now := time.Now()
+	requestCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+
+		if r.URL.Query().Get("page") == "2" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode([]Event{
+				{Type: "PushEvent", CreatedAt: now, Public: true, Repo: Repo{Name: "user/repo"}},
+			}); err != nil {
+				t.Errorf("Failed to encode events: %v", err)
+			}
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, r.URL.Path))
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode([]Event{
+			{Type: "PushEvent", CreatedAt: now, Public: true, Repo: Repo{Name: "user/repo"}},
+		}); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("pageuser", "testtoken", server.URL)
+	events, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if requestCount != 2 {
+		t.Errorf("Expected 2 requests (one per page), got: %d", requestCount)
+	}
+	if len(events) != 2 {
+		t.Errorf("Expected 2 events across both pages, got: %d", len(events))
+	}
+}
+
+func TestFetchUserEvents_NotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("notmodifieduser", "testtoken", server.URL)
+	events, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error on 304, got: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected 0 events on 304, got: %d", len(events))
+	}
+}
+
+func TestDoWithRateLimit_RetriesAfterSecondaryLimit(t *testing.T) {
+	now := time.Now()
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode([]Event{
+			{Type: "PushEvent", CreatedAt: now, Public: true, Repo: Repo{Name: "user/repo"}},
+		}); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("retryuser", "testtoken", server.URL)
+	events, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error after retry, got: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts (one throttled, one retried), got: %d", attempts)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected 1 event after retry, got: %d", len(events))
+	}
+}
+
+func TestDoWithRateLimit_SuccessDoesNotSleepWhenExhausted(t *testing.T) {
+	// A terminal 200 that happens to exhaust the rate-limit budget must
+	// return immediately: there's no further request to wait out the
+	// window for.
+	now := time.Now()
+	reset := now.Add(time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode([]Event{}); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("ratelimituser", "testtoken", server.URL)
+	start := time.Now()
+	if _, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected a successful response to return immediately, took: %v", elapsed)
+	}
+
+	limit := client.RateLimit()
+	if limit.Remaining != 0 {
+		t.Errorf("Expected RateLimit().Remaining=0, got: %d", limit.Remaining)
+	}
+	if !limit.Reset.Equal(time.Unix(reset.Unix(), 0)) {
+		t.Errorf("Expected RateLimit().Reset=%v, got: %v", reset, limit.Reset)
+	}
+}
+
+func TestDoWithRateLimit_SleepsUntilResetBeforeRetry(t *testing.T) {
+	// A retryable (403) response that exhausts the budget must wait out
+	// the reset window before the next attempt is issued.
+	now := time.Now()
+	reset := now.Add(10 * time.Millisecond)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if err := json.NewEncoder(w).Encode([]Event{}); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("ratelimituser", "testtoken", server.URL)
+	start := time.Now()
+	minWait := time.Until(reset)
+	if _, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < minWait {
+		t.Errorf("Expected the retry to wait until the reset time (%v), elapsed: %v", minWait, elapsed)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got: %d", attempts)
+	}
+}
+
+func TestDoWithRateLimit_RetriesServerErrorWithBackoff(t *testing.T) {
+	now := time.Now()
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode([]Event{
+			{Type: "PushEvent", CreatedAt: now, Public: true, Repo: Repo{Name: "user/repo"}},
+		}); err != nil {
+			t.Errorf("Failed to encode events: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("flakyuser", "testtoken", server.URL)
+	events, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error after retries, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts before success, got: %d", attempts)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected 1 event after retries, got: %d", len(events))
+	}
+}
+
+func TestDoWithRateLimit_GivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("alwaysdownuser", "testtoken", server.URL)
+	client.SetMaxRetries(1)
+
+	_, err := client.fetchUserEvents(context.Background(), time.Now().Add(-24*time.Hour))
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+	if !strings.Contains(err.Error(), "unexpected status code: 500") {
+		t.Errorf("Expected status code error, got: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts (1 initial + 1 retry), got: %d", attempts)
+	}
+}
+
+func TestGetDailyEventsContext_CancelStopsInFlightRequest(t *testing.T) {
+	started := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(2 * time.Second)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "testtoken", server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetDailyEventsContext(ctx)
+		done <- err
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Expected error after cancellation")
+		}
+		if !strings.Contains(err.Error(), "canceled") {
+			t.Errorf("Expected cancellation error, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected GetDailyEventsContext to return promptly after cancel")
+	}
+}
+
+func TestDoWithRateLimit_RetryWaitRespectsCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "testtoken", server.URL)
+	client.SetMaxRetries(5)
+
+	// The deadline expires while the client is waiting between retries of
+	// the 500 responses (at least 10ms, 20ms, 40ms, ... with backoff).
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetDailyEventsContext(ctx)
+	if err == nil {
+		t.Fatal("Expected error for canceled context")
+	}
+	if !strings.Contains(err.Error(), "canceled") {
+		t.Errorf("Expected cancellation error, got: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// linkNextPattern extracts the "next" URL from a GitHub Link header, e.g.
+// `<https://api.github.com/...&page=2>; rel="next", <...>; rel="last"`.
+var linkNextPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// nextPageURL returns the URL of the next page from a Link header, or ""
+// if there is no next page.
+func nextPageURL(linkHeader string) string {
+	matches := linkNextPattern.FindStringSubmatch(linkHeader)
+	if len(matches) < 2 {
+		return ""
+	}
+	return matches[1]
+}
+
+// rateLimitReset parses the X-RateLimit-Reset header (a Unix timestamp)
+// into the time at which the rate limit window resets.
+func rateLimitReset(header string) (time.Time, bool) {
+	if header == "" {
+		return time.Time{}, false
+	}
+
+	seconds, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(seconds, 0), true
+}
+
+// retryAfterDuration parses a Retry-After header given in seconds.
+func retryAfterDuration(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,34 @@
+// Package discord adapts the existing discord.Client webhook client to the
+// notify.Notifier interface.
+package discord
+
+import (
+	"context"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/discord"
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+// Notifier delivers daily reports to a Discord webhook.
+type Notifier struct {
+	client *discord.Client
+}
+
+// New creates a Discord notifier for the given webhook URL.
+func New(webhookURL string) *Notifier {
+	return &Notifier{client: discord.NewClient(webhookURL)}
+}
+
+// Send implements notify.Notifier. When report.Structured is set, it's
+// rendered as per-repo embed fields instead of the flattened summary text.
+func (n *Notifier) Send(ctx context.Context, report notify.Report) error {
+	if report.Structured != nil {
+		return n.client.SendDailyReportStructuredContext(ctx, *report.Structured)
+	}
+	return n.client.SendDailyReportContext(ctx, report.Summary)
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.client.SendErrorContext(ctx, sendErr)
+}
@@ -0,0 +1,74 @@
+// Package email implements notify.Notifier by sending the daily report as a
+// plain-text message over SMTP.
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+)
+
+// Notifier delivers daily reports by email over SMTP.
+type Notifier struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+	to       string
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// New creates an email notifier that authenticates to host:port with
+// username/password and sends from `from` to `to`.
+func New(host, port, username, password, from, to string) *Notifier {
+	return &Notifier{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		to:       to,
+		sendMail: smtp.SendMail,
+	}
+}
+
+// Send implements notify.Notifier. When rpt.Structured is set, it's
+// rendered as plain text with aggregate counts instead of just the
+// flattened summary text.
+func (n *Notifier) Send(_ context.Context, rpt notify.Report) error {
+	if rpt.Structured != nil {
+		body, err := report.PlainTextRenderer{}.Render(*rpt.Structured)
+		if err != nil {
+			return fmt.Errorf("failed to render report: %w", err)
+		}
+		return n.send("GitHub Daily", body)
+	}
+
+	return n.send("GitHub Daily", rpt.Summary)
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(_ context.Context, sendErr error) error {
+	return n.send("GitHub Daily Reporter - Error", fmt.Sprintf("Error occurred: %v", sendErr))
+}
+
+func (n *Notifier) send(subject, body string) error {
+	addr := fmt.Sprintf("%s:%s", n.host, n.port)
+	auth := smtp.PlainAuth("", n.username, n.password, n.host)
+
+	msg := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		n.from, n.to, subject, body,
+	)
+
+	if err := n.sendMail(addr, auth, n.from, []string{n.to}, []byte(msg)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func TestSend_SendsSummaryBySMTP(t *testing.T) {
+	var gotTo []string
+	var gotMsg []byte
+
+	notifier := New("smtp.example.org", "587", "user", "pass", "from@example.org", "to@example.org")
+	notifier.sendMail = func(_ string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+		gotTo = to
+		gotMsg = msg
+		return nil
+	}
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(gotTo) != 1 || gotTo[0] != "to@example.org" {
+		t.Errorf("Expected To=[to@example.org], got: %v", gotTo)
+	}
+	if !strings.Contains(string(gotMsg), "hi there") {
+		t.Errorf("Expected message body to contain the summary, got: %s", gotMsg)
+	}
+	if !strings.Contains(string(gotMsg), "Subject: GitHub Daily") {
+		t.Errorf("Expected subject line, got: %s", gotMsg)
+	}
+}
+
+func TestSend_SendMailErrorIsWrapped(t *testing.T) {
+	notifier := New("smtp.example.org", "587", "user", "pass", "from@example.org", "to@example.org")
+	notifier.sendMail = func(_ string, _ smtp.Auth, _ string, _ []string, _ []byte) error {
+		return errors.New("connection refused")
+	}
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error when sendMail fails")
+	}
+}
+
+func TestSendError_IncludesErrorMessage(t *testing.T) {
+	var gotMsg []byte
+
+	notifier := New("smtp.example.org", "587", "user", "pass", "from@example.org", "to@example.org")
+	notifier.sendMail = func(_ string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+		gotMsg = msg
+		return nil
+	}
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(string(gotMsg), "boom") {
+		t.Errorf("Expected message body to contain the error, got: %s", gotMsg)
+	}
+}
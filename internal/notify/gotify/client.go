@@ -0,0 +1,94 @@
+// Package gotify implements notify.Notifier over a Gotify server's
+// message API, authenticated with an application token.
+package gotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+// defaultPriority is the Gotify message priority used for daily reports
+// and error alerts alike.
+const defaultPriority = 5
+
+// message is the body POSTed to a Gotify server's /message endpoint.
+type message struct {
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+}
+
+// Notifier delivers daily reports to a Gotify server via its application
+// message API.
+type Notifier struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// New creates a Gotify notifier posting to the server at baseURL (e.g.
+// "https://gotify.example.com"), authenticated with an application token.
+func New(baseURL, token string) *Notifier {
+	return &Notifier{
+		baseURL: baseURL,
+		token:   token,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier.
+func (n *Notifier) Send(ctx context.Context, report notify.Report) error {
+	return n.post(ctx, message{
+		Title:    "GitHub Daily",
+		Message:  report.Summary,
+		Priority: defaultPriority,
+	})
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.post(ctx, message{
+		Title:    "GitHub Daily Reporter - Error",
+		Message:  fmt.Sprintf("Error occurred: %v", sendErr),
+		Priority: defaultPriority,
+	})
+}
+
+// post sends msg to POST /message?token=... on the Gotify server.
+func (n *Notifier) post(ctx context.Context, msg message) error {
+	jsonData, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/message?token=%s", n.baseURL, url.QueryEscape(n.token))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
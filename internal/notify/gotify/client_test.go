@@ -0,0 +1,77 @@
+package gotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *message) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("token"); got != "test-token" {
+			t.Errorf("Expected token=test-token, got: %s", got)
+		}
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PostsMessage(t *testing.T) {
+	var got message
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL, "test-token")
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.Title != "GitHub Daily" {
+		t.Errorf("Expected Title=GitHub Daily, got: %s", got.Title)
+	}
+	if got.Message != "hi there" {
+		t.Errorf("Expected Message=hi there, got: %s", got.Message)
+	}
+	if got.Priority != defaultPriority {
+		t.Errorf("Expected Priority=%d, got: %d", defaultPriority, got.Priority)
+	}
+}
+
+func TestSend_StatusNotOKReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	notifier := New(server.URL, "test-token")
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
+
+func TestSendError_IncludesErrorMessage(t *testing.T) {
+	var got message
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL, "test-token")
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.Title != "GitHub Daily Reporter - Error" {
+		t.Errorf("Expected error title, got: %s", got.Title)
+	}
+}
@@ -0,0 +1,89 @@
+// Package matrix implements notify.Notifier over the Matrix client-server
+// r0 "send" endpoint, posting plain-text messages to a room.
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+// messageEvent is the body of an m.room.message event.
+type messageEvent struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// Notifier delivers daily reports to a Matrix room via the
+// client-server API's "send" endpoint.
+type Notifier struct {
+	baseURL     string
+	roomID      string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// New creates a Matrix notifier that posts messages to roomID on the
+// homeserver at baseURL (e.g. "https://matrix.org"), authenticated with
+// accessToken.
+func New(baseURL, roomID, accessToken string) *Notifier {
+	return &Notifier{
+		baseURL:     baseURL,
+		roomID:      roomID,
+		accessToken: accessToken,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier.
+func (n *Notifier) Send(ctx context.Context, report notify.Report) error {
+	return n.send(ctx, fmt.Sprintf("GitHub Daily\n%s", report.Summary))
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.send(ctx, fmt.Sprintf("GitHub Daily Reporter - Error\nError occurred: %v", sendErr))
+}
+
+// send posts body as an m.room.message event via
+// PUT /_matrix/client/r0/rooms/{roomId}/send/m.room.message/{txnId}.
+func (n *Notifier) send(ctx context.Context, body string) error {
+	txnID := fmt.Sprintf("%d", time.Now().UnixNano())
+	url := fmt.Sprintf(
+		"%s/_matrix/client/r0/rooms/%s/send/m.room.message/%s",
+		n.baseURL, n.roomID, txnID,
+	)
+
+	jsonData, err := json.Marshal(messageEvent{MsgType: "m.text", Body: body})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", n.accessToken))
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
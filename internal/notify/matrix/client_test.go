@@ -0,0 +1,75 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *messageEvent) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Expected Authorization=Bearer test-token, got: %s", got)
+		}
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PutsMessageEvent(t *testing.T) {
+	var got messageEvent
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL, "!room:example.org", "test-token")
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.MsgType != "m.text" {
+		t.Errorf("Expected MsgType=m.text, got: %s", got.MsgType)
+	}
+	if !strings.Contains(got.Body, "hi there") {
+		t.Errorf("Expected body to contain the summary, got: %s", got.Body)
+	}
+}
+
+func TestSend_StatusNotOKReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	notifier := New(server.URL, "!room:example.org", "test-token")
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
+
+func TestSendError_IncludesErrorMessage(t *testing.T) {
+	var got messageEvent
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL, "!room:example.org", "test-token")
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(got.Body, "boom") {
+		t.Errorf("Expected body to contain the error, got: %s", got.Body)
+	}
+}
@@ -0,0 +1,94 @@
+// Package mattermost implements notify.Notifier over a Mattermost incoming
+// webhook.
+package mattermost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+)
+
+// maxMessageLen is Mattermost's default per-message character limit.
+const maxMessageLen = 16383
+
+// webhookPayload is the body posted to a Mattermost incoming webhook.
+type webhookPayload struct {
+	Text string `json:"text"`
+}
+
+// Notifier delivers daily reports to a Mattermost incoming webhook.
+type Notifier struct {
+	webhookURL string
+	httpClient *http.Client
+}
+
+// New creates a Mattermost notifier for the given incoming webhook URL.
+func New(webhookURL string) *Notifier {
+	return &Notifier{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier. When rpt.Structured is set, it's
+// rendered as GitHub-flavored Markdown with aggregate counts instead of
+// just the flattened summary text.
+func (n *Notifier) Send(ctx context.Context, rpt notify.Report) error {
+	if rpt.Structured != nil {
+		text, err := report.MarkdownRenderer{}.Render(*rpt.Structured)
+		if err != nil {
+			return fmt.Errorf("failed to render report: %w", err)
+		}
+		return n.post(ctx, truncate(text, maxMessageLen))
+	}
+
+	return n.post(ctx, fmt.Sprintf("#### GitHub Daily\n%s", truncate(rpt.Summary, maxMessageLen)))
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.post(ctx, fmt.Sprintf("#### GitHub Daily Reporter - Error\nError occurred: %v", sendErr))
+}
+
+func (n *Notifier) post(ctx context.Context, text string) error {
+	jsonData, err := json.Marshal(webhookPayload{Text: text})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// truncate ensures text fits within Mattermost's message length cap.
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return text[:maxLength]
+}
@@ -0,0 +1,77 @@
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *webhookPayload) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PostsSummaryAsMarkdown(t *testing.T) {
+	var got webhookPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(got.Text, "hi there") {
+		t.Errorf("Expected posted text to contain the summary, got: %s", got.Text)
+	}
+}
+
+func TestSend_StatusNotOKReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
+
+func TestSendError_PostsErrorText(t *testing.T) {
+	var got webhookPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(got.Text, "boom") {
+		t.Errorf("Expected posted text to contain the error, got: %s", got.Text)
+	}
+}
+
+func TestTruncate_CutsOverLongText(t *testing.T) {
+	got := truncate(strings.Repeat("a", maxMessageLen+10), maxMessageLen)
+
+	if len(got) != maxMessageLen {
+		t.Errorf("Expected truncated length=%d, got: %d", maxMessageLen, len(got))
+	}
+}
@@ -0,0 +1,67 @@
+// Package notify defines the shared contract for anything that can deliver
+// a daily report (Discord, Slack, Telegram, Mattermost, email, ...).
+package notify
+
+import (
+	"context"
+	"errors"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+)
+
+// Report is the generated daily summary to be delivered to a notifier.
+type Report struct {
+	Summary string
+
+	// Structured carries the aggregate counts behind Summary, letting a
+	// notifier render per-repo fields instead of just the flattened
+	// summary text. It's nil when no events were aggregated (e.g. the
+	// "no events" default message).
+	Structured *report.DailyReport
+}
+
+// Notifier is anything that can deliver a daily report or an error alert.
+type Notifier interface {
+	Send(ctx context.Context, report Report) error
+	SendError(ctx context.Context, err error) error
+}
+
+// Multi fans a report out to every configured notifier, aggregating
+// failures so a single backend going down doesn't stop the others from
+// being notified.
+type Multi struct {
+	notifiers []Notifier
+}
+
+// NewMulti builds a Multi dispatcher over the given notifiers.
+func NewMulti(notifiers ...Notifier) *Multi {
+	return &Multi{notifiers: notifiers}
+}
+
+// Send delivers the report to every notifier, returning a joined error for
+// any that failed.
+func (m *Multi) Send(ctx context.Context, report Report) error {
+	var errs []error
+
+	for _, n := range m.notifiers {
+		if err := n.Send(ctx, report); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SendError delivers an error alert to every notifier, returning a joined
+// error for any that failed.
+func (m *Multi) SendError(ctx context.Context, sendErr error) error {
+	var errs []error
+
+	for _, n := range m.notifiers {
+		if err := n.SendError(ctx, sendErr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubNotifier struct {
+	sendErr      error
+	sendErrorErr error
+}
+
+func (s *stubNotifier) Send(_ context.Context, _ Report) error {
+	return s.sendErr
+}
+
+func (s *stubNotifier) SendError(_ context.Context, _ error) error {
+	return s.sendErrorErr
+}
+
+func TestMulti_Send_AggregatesFailures(t *testing.T) {
+	ok := &stubNotifier{}
+	failing := &stubNotifier{sendErr: errors.New("boom")}
+
+	multi := NewMulti(ok, failing)
+
+	err := multi.Send(context.Background(), Report{Summary: "test"})
+	if err == nil {
+		t.Fatal("Expected an aggregated error from the failing notifier")
+	}
+}
+
+func TestMulti_Send_AllSucceed(t *testing.T) {
+	multi := NewMulti(&stubNotifier{}, &stubNotifier{})
+
+	if err := multi.Send(context.Background(), Report{Summary: "test"}); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestMulti_SendError_AggregatesFailures(t *testing.T) {
+	failing := &stubNotifier{sendErrorErr: errors.New("boom")}
+	multi := NewMulti(&stubNotifier{}, failing)
+
+	err := multi.SendError(context.Background(), errors.New("original error"))
+	if err == nil {
+		t.Fatal("Expected an aggregated error from the failing notifier")
+	}
+}
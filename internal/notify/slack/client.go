@@ -0,0 +1,125 @@
+// Package slack implements notify.Notifier over a Slack incoming webhook,
+// rendering reports as Block Kit sections.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+	"github.com/carvalhocaio/routines-in-the-night/internal/report"
+)
+
+// maxSectionTextLen is Slack's limit for a single Block Kit section's text.
+const maxSectionTextLen = 3000
+
+// Block represents a single Slack Block Kit block.
+type Block struct {
+	Type string     `json:"type"`
+	Text *BlockText `json:"text,omitempty"`
+}
+
+// BlockText represents the text object inside a Block Kit block.
+type BlockText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// webhookPayload is the body posted to a Slack incoming webhook.
+type webhookPayload struct {
+	Blocks []Block `json:"blocks"`
+}
+
+// Notifier delivers daily reports to a Slack incoming webhook.
+type Notifier struct {
+	webhookURL string
+	httpClient *http.Client
+}
+
+// New creates a Slack notifier for the given incoming webhook URL.
+func New(webhookURL string) *Notifier {
+	return &Notifier{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier. When rpt.Structured is set, it's
+// rendered as Block Kit sections with per-repo commit counts instead of
+// just the flattened summary text.
+func (n *Notifier) Send(ctx context.Context, rpt notify.Report) error {
+	if rpt.Structured != nil {
+		return n.post(ctx, toBlocks(report.SlackBlockRenderer{}.RenderBlocks(*rpt.Structured)))
+	}
+
+	return n.post(ctx, []Block{
+		{Type: "header", Text: &BlockText{Type: "plain_text", Text: "GitHub Daily"}},
+		{Type: "section", Text: &BlockText{Type: "mrkdwn", Text: truncate(rpt.Summary, maxSectionTextLen)}},
+	})
+}
+
+// toBlocks adapts report.SlackBlock (shared with the standalone renderer)
+// to this package's Block type.
+func toBlocks(blocks []report.SlackBlock) []Block {
+	out := make([]Block, 0, len(blocks))
+	for _, b := range blocks {
+		block := Block{Type: b.Type}
+		if b.Text != nil {
+			block.Text = &BlockText{Type: b.Text.Type, Text: b.Text.Text}
+		}
+		out = append(out, block)
+	}
+	return out
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.post(ctx, []Block{
+		{Type: "header", Text: &BlockText{Type: "plain_text", Text: "GitHub Daily Reporter - Error"}},
+		{Type: "section", Text: &BlockText{
+			Type: "mrkdwn",
+			Text: truncate(fmt.Sprintf("Error occurred: %v", sendErr), maxSectionTextLen),
+		}},
+	})
+}
+
+func (n *Notifier) post(ctx context.Context, blocks []Block) error {
+	jsonData, err := json.Marshal(webhookPayload{Blocks: blocks})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// truncate ensures text fits within Slack's per-block limit.
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return text[:maxLength]
+}
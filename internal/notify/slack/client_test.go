@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *webhookPayload) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PostsSummaryAsBlocks(t *testing.T) {
+	var got webhookPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(got.Blocks) != 2 {
+		t.Fatalf("Expected 2 blocks, got: %d", len(got.Blocks))
+	}
+	if got.Blocks[1].Text.Text != "hi there" {
+		t.Errorf("Expected section text=hi there, got: %s", got.Blocks[1].Text.Text)
+	}
+}
+
+func TestSend_StatusNotOKReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
+
+func TestSendError_PostsErrorBlocks(t *testing.T) {
+	var got webhookPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(got.Blocks) != 2 || got.Blocks[0].Text.Text != "GitHub Daily Reporter - Error" {
+		t.Errorf("Expected an error header block, got: %+v", got.Blocks)
+	}
+}
@@ -0,0 +1,127 @@
+// Package telegram implements notify.Notifier over the Telegram Bot API.
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+const (
+	telegramAPIURL = "https://api.telegram.org"
+
+	// maxMessageLen is Telegram's character cap for a single sendMessage call.
+	maxMessageLen = 4096
+)
+
+// sendMessageRequest is the body posted to the Bot API's sendMessage method.
+type sendMessageRequest struct {
+	ChatID    string `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode"`
+}
+
+// Notifier delivers daily reports via a Telegram bot.
+type Notifier struct {
+	baseURL    string
+	botToken   string
+	chatID     string
+	httpClient *http.Client
+}
+
+// New creates a Telegram notifier that posts to chatID using botToken.
+func New(botToken, chatID string) *Notifier {
+	return NewWithBaseURL(botToken, chatID, telegramAPIURL)
+}
+
+// NewWithBaseURL creates a Telegram notifier pointed at a custom base URL
+// (used in tests to point at an httptest.Server).
+func NewWithBaseURL(botToken, chatID, baseURL string) *Notifier {
+	return &Notifier{
+		baseURL:  baseURL,
+		botToken: botToken,
+		chatID:   chatID,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier.
+func (n *Notifier) Send(ctx context.Context, report notify.Report) error {
+	return n.sendMessage(ctx, escapeMarkdownV2(report.Summary))
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	text := fmt.Sprintf("*GitHub Daily Reporter \\- Error*\n%s", escapeMarkdownV2(sendErr.Error()))
+	return n.sendMessage(ctx, text)
+}
+
+func (n *Notifier) sendMessage(ctx context.Context, text string) error {
+	url := fmt.Sprintf("%s/bot%s/sendMessage", n.baseURL, n.botToken)
+
+	jsonData, err := json.Marshal(sendMessageRequest{
+		ChatID:    n.chatID,
+		Text:      truncate(text, maxMessageLen),
+		ParseMode: "MarkdownV2",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// truncate ensures text fits within Telegram's message length cap. Cutting
+// an already-escaped MarkdownV2 string can land right after one of
+// escapeMarkdownV2's inserted backslashes, leaving a dangling escape that
+// Telegram's parser rejects with a 400 — trim it off when that happens.
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	cut := text[:maxLength]
+	return strings.TrimSuffix(cut, `\`)
+}
+
+// markdownV2Special lists the characters Telegram's MarkdownV2 parser
+// requires to be escaped outside of an entity.
+const markdownV2Special = "_*[]()~`>#+-=|{}.!"
+
+// escapeMarkdownV2 escapes reserved characters so free-form text renders
+// safely under Telegram's MarkdownV2 parse mode.
+func escapeMarkdownV2(text string) string {
+	var builder strings.Builder
+	for _, r := range text {
+		if strings.ContainsRune(markdownV2Special, r) {
+			builder.WriteRune('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
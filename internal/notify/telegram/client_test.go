@@ -0,0 +1,82 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *sendMessageRequest) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PostsEscapedSummary(t *testing.T) {
+	var got sendMessageRequest
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := NewWithBaseURL("token", "chat-1", server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "Hello (world)."}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.ChatID != "chat-1" {
+		t.Errorf("Expected ChatID=chat-1, got: %s", got.ChatID)
+	}
+	if got.ParseMode != "MarkdownV2" {
+		t.Errorf("Expected ParseMode=MarkdownV2, got: %s", got.ParseMode)
+	}
+	if got.Text != `Hello \(world\)\.` {
+		t.Errorf("Expected escaped text, got: %s", got.Text)
+	}
+}
+
+func TestSend_StatusNotOKReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusBadRequest, nil)
+	defer server.Close()
+
+	notifier := NewWithBaseURL("token", "chat-1", server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
+
+func TestTruncate_DoesNotLeaveADanglingEscape(t *testing.T) {
+	// "a." escapes to "a\.". Cutting right after the inserted backslash
+	// (maxLength=2) would otherwise leave a trailing lone "\" that
+	// Telegram's MarkdownV2 parser rejects.
+	escaped := escapeMarkdownV2("a.b")
+
+	got := truncate(escaped, 2)
+
+	if strings.HasSuffix(got, `\`) {
+		t.Errorf("Expected no dangling trailing backslash, got: %q", got)
+	}
+	if got != "a" {
+		t.Errorf("Expected the dangling escape to be trimmed to %q, got: %q", "a", got)
+	}
+}
+
+func TestTruncate_LeavesWellFormedTextUntouched(t *testing.T) {
+	got := truncate("hello", 100)
+
+	if got != "hello" {
+		t.Errorf("Expected text under the limit to be returned unchanged, got: %q", got)
+	}
+}
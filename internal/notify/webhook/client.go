@@ -0,0 +1,75 @@
+// Package webhook implements notify.Notifier by POSTing a generic JSON
+// payload to any URL, for notification backends that don't need bespoke
+// formatting (e.g. an internal automation endpoint).
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+// eventPayload is the body POSTed to the configured URL.
+type eventPayload struct {
+	Event   string `json:"event"`
+	Summary string `json:"summary,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Notifier delivers daily reports to a generic JSON webhook URL.
+type Notifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// New creates a generic webhook notifier that posts to url.
+func New(url string) *Notifier {
+	return &Notifier{
+		url: url,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send implements notify.Notifier.
+func (n *Notifier) Send(ctx context.Context, report notify.Report) error {
+	return n.post(ctx, eventPayload{Event: "daily_report", Summary: report.Summary})
+}
+
+// SendError implements notify.Notifier.
+func (n *Notifier) SendError(ctx context.Context, sendErr error) error {
+	return n.post(ctx, eventPayload{Event: "error", Error: sendErr.Error()})
+}
+
+func (n *Notifier) post(ctx context.Context, payload eventPayload) error {
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/notify"
+)
+
+func newTestServer(t *testing.T, status int, capture *eventPayload) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if capture != nil {
+			if err := json.NewDecoder(r.Body).Decode(capture); err != nil {
+				t.Fatalf("Expected valid JSON body, got error: %v", err)
+			}
+		}
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSend_PostsDailyReportEvent(t *testing.T) {
+	var got eventPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi there"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.Event != "daily_report" {
+		t.Errorf("Expected Event=daily_report, got: %s", got.Event)
+	}
+	if got.Summary != "hi there" {
+		t.Errorf("Expected Summary=hi there, got: %s", got.Summary)
+	}
+}
+
+func TestSend_AcceptsAny2xxStatus(t *testing.T) {
+	server := newTestServer(t, http.StatusAccepted, nil)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err != nil {
+		t.Errorf("Expected no error for a 2xx response, got: %v", err)
+	}
+}
+
+func TestSend_StatusOutside2xxReturnsError(t *testing.T) {
+	server := newTestServer(t, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.Send(context.Background(), notify.Report{Summary: "hi"}); err == nil {
+		t.Fatal("Expected an error for a non-2xx response")
+	}
+}
+
+func TestSendError_PostsErrorEvent(t *testing.T) {
+	var got eventPayload
+	server := newTestServer(t, http.StatusOK, &got)
+	defer server.Close()
+
+	notifier := New(server.URL)
+
+	if err := notifier.SendError(context.Background(), errors.New("boom")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got.Event != "error" || got.Error != "boom" {
+		t.Errorf("Expected error event with Error=boom, got: %+v", got)
+	}
+}
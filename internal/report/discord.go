@@ -0,0 +1,87 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxDiscordDescriptionLen is Discord's embed description character limit.
+const maxDiscordDescriptionLen = 4096
+
+// DiscordEmbed mirrors the subset of a Discord embed that
+// DiscordEmbedRenderer produces: a title, a description, and inline
+// fields (one per repo) rather than one long truncated block of text.
+type DiscordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+}
+
+// DiscordEmbedField is a single named field within a Discord embed.
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// DiscordEmbedRenderer renders a DailyReport as a single Discord embed
+// (returned as a one-element list), using per-repo fields for commit activity instead of truncating
+// a single long description mid-sentence.
+type DiscordEmbedRenderer struct{}
+
+// Render implements Renderer, returning the JSON-encoded embed list.
+func (r DiscordEmbedRenderer) Render(dr DailyReport) (string, error) {
+	embeds := r.RenderEmbeds(dr)
+
+	data, err := json.Marshal(embeds)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Discord embeds: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// RenderEmbeds returns the structured embeds directly, for callers (like
+// notify/discord) that post them without re-parsing JSON.
+func (DiscordEmbedRenderer) RenderEmbeds(dr DailyReport) []DiscordEmbed {
+	embed := DiscordEmbed{
+		Title:       "GitHub Daily",
+		Description: truncateDescription(dr.Summary),
+		Fields: []DiscordEmbedField{
+			{
+				Name:   "Activity",
+				Value: fmt.Sprintf(
+					"Pull requests: %d opened, %d closed\nIssues: %d opened, %d closed\nBranches: %d created, %d deleted",
+					dr.PRsOpened, dr.PRsClosed, dr.IssuesOpened, dr.IssuesClosed, dr.BranchesCreated, dr.BranchesDeleted,
+				),
+			},
+		},
+	}
+
+	for _, repo := range sortedRepos(dr.CommitsByRepo) {
+		embed.Fields = append(embed.Fields, DiscordEmbedField{
+			Name:   repo,
+			Value:  fmt.Sprintf("%d commit(s)", dr.CommitsByRepo[repo]),
+			Inline: true,
+		})
+	}
+
+	return []DiscordEmbed{embed}
+}
+
+// truncateDescription trims text to Discord's description limit, preferring
+// to cut at the last complete sentence rather than mid-word.
+func truncateDescription(text string) string {
+	if len(text) <= maxDiscordDescriptionLen {
+		return text
+	}
+
+	truncated := text[:maxDiscordDescriptionLen]
+	for i := len(truncated) - 1; i >= 0; i-- {
+		if truncated[i] == '.' {
+			return text[:i+1]
+		}
+	}
+
+	return truncated
+}
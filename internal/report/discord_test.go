@@ -0,0 +1,76 @@
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDiscordEmbedRenderer_RenderEmbeds(t *testing.T) {
+	dr := DailyReport{
+		CommitsByRepo: map[string]int{"owner/repo-b": 1, "owner/repo-a": 2},
+		PRsOpened:     1,
+	}
+
+	embeds := DiscordEmbedRenderer{}.RenderEmbeds(dr)
+
+	if len(embeds) != 1 {
+		t.Fatalf("Expected 1 embed, got %d", len(embeds))
+	}
+	// The "Activity" counts field comes first, followed by one per-repo field.
+	if len(embeds[0].Fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(embeds[0].Fields))
+	}
+	if embeds[0].Fields[1].Name != "owner/repo-a" {
+		t.Errorf("Expected repo fields sorted alphabetically, got first=%s", embeds[0].Fields[1].Name)
+	}
+	if !embeds[0].Fields[1].Inline {
+		t.Error("Expected repo fields to be inline")
+	}
+}
+
+func TestDiscordEmbedRenderer_RenderEmbeds_DescriptionIsSummary(t *testing.T) {
+	dr := DailyReport{
+		Summary:       "Today you shipped a bunch of fixes.",
+		CommitsByRepo: map[string]int{},
+	}
+
+	embeds := DiscordEmbedRenderer{}.RenderEmbeds(dr)
+
+	if embeds[0].Description != dr.Summary {
+		t.Errorf("Expected description to be the narrative summary, got: %q", embeds[0].Description)
+	}
+}
+
+func TestDiscordEmbedRenderer_Render(t *testing.T) {
+	dr := DailyReport{CommitsByRepo: map[string]int{}}
+
+	out, err := DiscordEmbedRenderer{}.Render(dr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var embeds []DiscordEmbed
+	if err := json.Unmarshal([]byte(out), &embeds); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+}
+
+func TestTruncateDescription(t *testing.T) {
+	longText := strings.Repeat("This is a sentence. ", 300)
+
+	got := truncateDescription(longText)
+
+	if len(got) > maxDiscordDescriptionLen {
+		t.Errorf("Expected truncated description within limit, got length %d", len(got))
+	}
+	if !strings.HasSuffix(got, ".") {
+		t.Errorf("Expected truncation at sentence boundary, got: %q", got[len(got)-20:])
+	}
+}
+
+func TestTruncateDescription_UnderLimit(t *testing.T) {
+	if got := truncateDescription("short"); got != "short" {
+		t.Errorf("Expected no truncation, got: %q", got)
+	}
+}
@@ -0,0 +1,47 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MarkdownRenderer renders a DailyReport as GitHub-flavored Markdown.
+type MarkdownRenderer struct{}
+
+// Render implements Renderer.
+func (MarkdownRenderer) Render(dr DailyReport) (string, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## GitHub Daily (%s - %s)\n\n", dr.From.Format("2006-01-02"), dr.To.Format("2006-01-02"))
+
+	if dr.Summary != "" {
+		fmt.Fprintf(&b, "%s\n\n", dr.Summary)
+	}
+
+	fmt.Fprintf(&b, "- Pull requests: %d opened, %d closed\n", dr.PRsOpened, dr.PRsClosed)
+	fmt.Fprintf(&b, "- Issues: %d opened, %d closed\n", dr.IssuesOpened, dr.IssuesClosed)
+	fmt.Fprintf(&b, "- Branches: %d created, %d deleted\n\n", dr.BranchesCreated, dr.BranchesDeleted)
+
+	if len(dr.CommitsByRepo) == 0 {
+		return b.String(), nil
+	}
+
+	b.WriteString("### Commits by repo\n")
+	for _, repo := range sortedRepos(dr.CommitsByRepo) {
+		fmt.Fprintf(&b, "- `%s`: %d commit(s)\n", repo, dr.CommitsByRepo[repo])
+	}
+
+	return b.String(), nil
+}
+
+// sortedRepos returns the keys of commitsByRepo in a stable, deterministic
+// order so rendered output doesn't jitter between runs.
+func sortedRepos(commitsByRepo map[string]int) []string {
+	repos := make([]string, 0, len(commitsByRepo))
+	for repo := range commitsByRepo {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	return repos
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarkdownRenderer_Render(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	dr := DailyReport{
+		From:          from,
+		To:            to,
+		CommitsByRepo: map[string]int{"owner/repo-b": 1, "owner/repo-a": 2},
+		PRsOpened:     1,
+		PRsClosed:     2,
+	}
+
+	out, err := MarkdownRenderer{}.Render(dr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(out, "## GitHub Daily (2024-01-01 - 2024-01-02)") {
+		t.Errorf("Expected header with date range, got: %s", out)
+	}
+	if !strings.Contains(out, "Pull requests: 1 opened, 2 closed") {
+		t.Errorf("Expected PR counts, got: %s", out)
+	}
+
+	idxA := strings.Index(out, "owner/repo-a")
+	idxB := strings.Index(out, "owner/repo-b")
+	if idxA == -1 || idxB == -1 || idxA > idxB {
+		t.Errorf("Expected repos sorted alphabetically, got: %s", out)
+	}
+}
+
+func TestMarkdownRenderer_NoCommits(t *testing.T) {
+	dr := DailyReport{CommitsByRepo: map[string]int{}}
+
+	out, err := MarkdownRenderer{}.Render(dr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Contains(out, "Commits by repo") {
+		t.Errorf("Expected no commits section, got: %s", out)
+	}
+}
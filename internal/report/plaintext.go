@@ -0,0 +1,36 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlainTextRenderer renders a DailyReport as unformatted plain text, for
+// sinks that don't support markup (e.g. SMS-style notifiers).
+type PlainTextRenderer struct{}
+
+// Render implements Renderer.
+func (PlainTextRenderer) Render(dr DailyReport) (string, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "GitHub Daily (%s - %s)\n\n", dr.From.Format("2006-01-02"), dr.To.Format("2006-01-02"))
+
+	if dr.Summary != "" {
+		fmt.Fprintf(&b, "%s\n\n", dr.Summary)
+	}
+
+	fmt.Fprintf(&b, "Pull requests: %d opened, %d closed\n", dr.PRsOpened, dr.PRsClosed)
+	fmt.Fprintf(&b, "Issues: %d opened, %d closed\n", dr.IssuesOpened, dr.IssuesClosed)
+	fmt.Fprintf(&b, "Branches: %d created, %d deleted\n\n", dr.BranchesCreated, dr.BranchesDeleted)
+
+	if len(dr.CommitsByRepo) == 0 {
+		return b.String(), nil
+	}
+
+	b.WriteString("Commits by repo:\n")
+	for _, repo := range sortedRepos(dr.CommitsByRepo) {
+		fmt.Fprintf(&b, "- %s: %d commit(s)\n", repo, dr.CommitsByRepo[repo])
+	}
+
+	return b.String(), nil
+}
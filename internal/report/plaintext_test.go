@@ -0,0 +1,25 @@
+package report
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlainTextRenderer_Render(t *testing.T) {
+	dr := DailyReport{
+		CommitsByRepo: map[string]int{"owner/repo-a": 4},
+		IssuesOpened:  2,
+	}
+
+	out, err := PlainTextRenderer{}.Render(dr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(out, "Issues: 2 opened, 0 closed") {
+		t.Errorf("Expected issue counts, got: %s", out)
+	}
+	if !strings.Contains(out, "- owner/repo-a: 4 commit(s)") {
+		t.Errorf("Expected commit line, got: %s", out)
+	}
+}
@@ -0,0 +1,84 @@
+// Package report builds a structured daily activity report from formatted
+// events and renders it for different notification sinks, so aggregate
+// counts (commits, PRs, issues, branches) survive past the point where a
+// plain LLM-generated summary would have flattened them into prose.
+package report
+
+import (
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// DailyReport is the structured activity summary for a time range, built
+// directly from the events a Source collected.
+type DailyReport struct {
+	Events []source.FormattedEvent
+	From   time.Time
+	To     time.Time
+
+	// Summary is the LLM-generated narrative for the same window, so
+	// renderers can show it alongside the structured counts instead of
+	// replacing it with them.
+	Summary string
+
+	CommitsByRepo   map[string]int
+	PRsOpened       int
+	PRsClosed       int
+	IssuesOpened    int
+	IssuesClosed    int
+	BranchesCreated int
+	BranchesDeleted int
+}
+
+// NewDailyReport aggregates events into a DailyReport covering [from, to).
+func NewDailyReport(events []source.FormattedEvent, from, to time.Time) DailyReport {
+	dr := DailyReport{
+		Events:        events,
+		From:          from,
+		To:            to,
+		CommitsByRepo: make(map[string]int),
+	}
+
+	for i := range events {
+		event := &events[i]
+
+		switch event.Type {
+		case "PushEvent":
+			dr.CommitsByRepo[event.Repo] += event.Commits
+
+		case "PullRequestEvent":
+			switch event.Action {
+			case "opened":
+				dr.PRsOpened++
+			case "closed":
+				dr.PRsClosed++
+			}
+
+		case "IssuesEvent":
+			switch event.Action {
+			case "opened":
+				dr.IssuesOpened++
+			case "closed":
+				dr.IssuesClosed++
+			}
+
+		case "CreateEvent":
+			if event.RefType == "branch" {
+				dr.BranchesCreated++
+			}
+
+		case "DeleteEvent":
+			if event.RefType == "branch" {
+				dr.BranchesDeleted++
+			}
+		}
+	}
+
+	return dr
+}
+
+// Renderer formats a DailyReport for a particular notification sink.
+type Renderer interface {
+	Render(dr DailyReport) (string, error)
+}
@@ -0,0 +1,59 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+func TestNewDailyReport_Aggregates(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	events := []source.FormattedEvent{
+		{Type: "PushEvent", Repo: "owner/repo-a", Commits: 2},
+		{Type: "PushEvent", Repo: "owner/repo-a", Commits: 1},
+		{Type: "PushEvent", Repo: "owner/repo-b", Commits: 5},
+		{Type: "PullRequestEvent", Action: "opened"},
+		{Type: "PullRequestEvent", Action: "closed"},
+		{Type: "PullRequestEvent", Action: "closed"},
+		{Type: "IssuesEvent", Action: "opened"},
+		{Type: "IssuesEvent", Action: "closed"},
+		{Type: "CreateEvent", RefType: "branch"},
+		{Type: "CreateEvent", RefType: "repository"},
+		{Type: "DeleteEvent", RefType: "branch"},
+	}
+
+	dr := NewDailyReport(events, from, to)
+
+	if dr.From != from || dr.To != to {
+		t.Errorf("Expected From/To to be preserved, got From=%v To=%v", dr.From, dr.To)
+	}
+	if dr.CommitsByRepo["owner/repo-a"] != 3 {
+		t.Errorf("Expected owner/repo-a=3 commits, got %d", dr.CommitsByRepo["owner/repo-a"])
+	}
+	if dr.CommitsByRepo["owner/repo-b"] != 5 {
+		t.Errorf("Expected owner/repo-b=5 commits, got %d", dr.CommitsByRepo["owner/repo-b"])
+	}
+	if dr.PRsOpened != 1 || dr.PRsClosed != 2 {
+		t.Errorf("Expected PRsOpened=1 PRsClosed=2, got %d/%d", dr.PRsOpened, dr.PRsClosed)
+	}
+	if dr.IssuesOpened != 1 || dr.IssuesClosed != 1 {
+		t.Errorf("Expected IssuesOpened=1 IssuesClosed=1, got %d/%d", dr.IssuesOpened, dr.IssuesClosed)
+	}
+	if dr.BranchesCreated != 1 || dr.BranchesDeleted != 1 {
+		t.Errorf("Expected BranchesCreated=1 BranchesDeleted=1, got %d/%d", dr.BranchesCreated, dr.BranchesDeleted)
+	}
+}
+
+func TestNewDailyReport_Empty(t *testing.T) {
+	dr := NewDailyReport(nil, time.Time{}, time.Time{})
+
+	if len(dr.CommitsByRepo) != 0 {
+		t.Errorf("Expected empty CommitsByRepo, got %v", dr.CommitsByRepo)
+	}
+	if dr.PRsOpened != 0 || dr.PRsClosed != 0 {
+		t.Errorf("Expected no PR counts, got %d/%d", dr.PRsOpened, dr.PRsClosed)
+	}
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxSlackSectionLen is Slack's limit for a single Block Kit section's
+// text, mirroring notify/slack's own limit.
+const maxSlackSectionLen = 3000
+
+// SlackBlock is a single Slack Block Kit block.
+type SlackBlock struct {
+	Type string          `json:"type"`
+	Text *SlackBlockText `json:"text,omitempty"`
+}
+
+// SlackBlockText is the text object inside a Block Kit block.
+type SlackBlockText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackBlockRenderer renders a DailyReport as Slack Block Kit JSON.
+type SlackBlockRenderer struct{}
+
+// Render implements Renderer, returning the JSON-encoded block list.
+func (r SlackBlockRenderer) Render(dr DailyReport) (string, error) {
+	blocks := r.RenderBlocks(dr)
+
+	data, err := json.Marshal(blocks)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Slack blocks: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// RenderBlocks returns the structured blocks directly, for callers (like
+// notify/slack) that post them without re-parsing JSON.
+func (SlackBlockRenderer) RenderBlocks(dr DailyReport) []SlackBlock {
+	blocks := []SlackBlock{
+		{Type: "header", Text: &SlackBlockText{Type: "plain_text", Text: "GitHub Daily"}},
+	}
+
+	if dr.Summary != "" {
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackBlockText{Type: "mrkdwn", Text: truncate(dr.Summary, maxSlackSectionLen)},
+		})
+	}
+
+	blocks = append(blocks, SlackBlock{Type: "section", Text: &SlackBlockText{
+		Type: "mrkdwn",
+		Text: truncate(fmt.Sprintf(
+			"Pull requests: %d opened, %d closed\nIssues: %d opened, %d closed\nBranches: %d created, %d deleted",
+			dr.PRsOpened, dr.PRsClosed, dr.IssuesOpened, dr.IssuesClosed, dr.BranchesCreated, dr.BranchesDeleted,
+		), maxSlackSectionLen),
+	}})
+
+	if len(dr.CommitsByRepo) == 0 {
+		return blocks
+	}
+
+	var commitLines string
+	for _, repo := range sortedRepos(dr.CommitsByRepo) {
+		commitLines += fmt.Sprintf("- %s: %d commit(s)\n", repo, dr.CommitsByRepo[repo])
+	}
+
+	return append(blocks, SlackBlock{
+		Type: "section",
+		Text: &SlackBlockText{Type: "mrkdwn", Text: truncate(commitLines, maxSlackSectionLen)},
+	})
+}
+
+// truncate ensures text fits within Slack's per-block limit.
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return text[:maxLength]
+}
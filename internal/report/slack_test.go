@@ -0,0 +1,59 @@
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSlackBlockRenderer_RenderBlocks(t *testing.T) {
+	dr := DailyReport{
+		CommitsByRepo: map[string]int{"owner/repo-a": 3},
+		PRsOpened:     1,
+	}
+
+	blocks := SlackBlockRenderer{}.RenderBlocks(dr)
+
+	if len(blocks) != 3 {
+		t.Fatalf("Expected 3 blocks (header, summary, commits), got %d", len(blocks))
+	}
+	if blocks[0].Type != "header" {
+		t.Errorf("Expected first block type=header, got %s", blocks[0].Type)
+	}
+	if !strings.Contains(blocks[2].Text.Text, "owner/repo-a: 3 commit(s)") {
+		t.Errorf("Expected commits block to mention repo, got: %s", blocks[2].Text.Text)
+	}
+}
+
+func TestSlackBlockRenderer_NoCommits(t *testing.T) {
+	dr := DailyReport{}
+
+	blocks := SlackBlockRenderer{}.RenderBlocks(dr)
+
+	if len(blocks) != 2 {
+		t.Errorf("Expected 2 blocks when no commits, got %d", len(blocks))
+	}
+}
+
+func TestSlackBlockRenderer_Render(t *testing.T) {
+	dr := DailyReport{CommitsByRepo: map[string]int{}}
+
+	out, err := SlackBlockRenderer{}.Render(dr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var blocks []SlackBlock
+	if err := json.Unmarshal([]byte(out), &blocks); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("hello", 3); got != "hel" {
+		t.Errorf("Expected truncation to 3 chars, got: %q", got)
+	}
+	if got := truncate("hi", 10); got != "hi" {
+		t.Errorf("Expected no truncation, got: %q", got)
+	}
+}
@@ -0,0 +1,22 @@
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/store"
+)
+
+// NewWeeklyDigest builds a DailyReport covering the 7 days up to now,
+// backfilled from st's persisted history rather than a single run's fetch
+// window, so a digest can span more than the last run's lookback.
+func NewWeeklyDigest(st store.Store, now time.Time) (DailyReport, error) {
+	from := now.AddDate(0, 0, -7)
+
+	events, err := st.EventsBetween(from, now)
+	if err != nil {
+		return DailyReport{}, fmt.Errorf("failed to load events for weekly digest: %w", err)
+	}
+
+	return NewDailyReport(events, from, now), nil
+}
@@ -0,0 +1,53 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// fakeStore is a minimal store.Store used to test NewWeeklyDigest's
+// backfill without depending on a real backend.
+type fakeStore struct {
+	events []source.FormattedEvent
+}
+
+func (s *fakeStore) SaveEvents([]source.FormattedEvent) error { return nil }
+
+func (s *fakeStore) SeenEventIDs() (map[string]bool, error) { return nil, nil }
+
+func (s *fakeStore) LastRunAt() (time.Time, error) { return time.Time{}, nil }
+
+func (s *fakeStore) MarkRun(time.Time) error { return nil }
+
+func (s *fakeStore) EventsBetween(from, to time.Time) ([]source.FormattedEvent, error) {
+	var events []source.FormattedEvent
+	for _, e := range s.events {
+		if !e.CreateAt.Before(from) && e.CreateAt.Before(to) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func TestNewWeeklyDigest_BackfillsSevenDaysFromStore(t *testing.T) {
+	now := time.Date(2026, 7, 29, 0, 0, 0, 0, time.UTC)
+
+	st := &fakeStore{events: []source.FormattedEvent{
+		{ID: "1", Type: "PushEvent", Repo: "a/b", Commits: 2, CreateAt: now.AddDate(0, 0, -3)},
+		{ID: "2", Type: "PushEvent", Repo: "a/b", Commits: 1, CreateAt: now.AddDate(0, 0, -10)},
+	}}
+
+	dr, err := NewWeeklyDigest(st, now)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if dr.CommitsByRepo["a/b"] != 2 {
+		t.Errorf("Expected only the in-range event's commits counted, got: %d", dr.CommitsByRepo["a/b"])
+	}
+	if !dr.From.Equal(now.AddDate(0, 0, -7)) || !dr.To.Equal(now) {
+		t.Errorf("Expected digest window [%v, %v), got: [%v, %v)", now.AddDate(0, 0, -7), now, dr.From, dr.To)
+	}
+}
@@ -0,0 +1,142 @@
+// Package bitbucket implements source.Source against the Bitbucket Cloud
+// repositories API.
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+const bitbucketAPIURL = "https://api.bitbucket.org/2.0"
+
+// Client handles Bitbucket API interactions
+type Client struct {
+	username    string
+	appPassword string
+	baseURL     string
+	httpClient  *http.Client
+}
+
+// eventsResponse is the paginated envelope Bitbucket wraps list responses in.
+type eventsResponse struct {
+	Values []Event `json:"values"`
+}
+
+// Event represents a Bitbucket repository activity entry
+type Event struct {
+	Type       string     `json:"type"`
+	CreatedAt  time.Time  `json:"created_on"`
+	Repository Repository `json:"repository"`
+	Commit     *Commit    `json:"commit"`
+}
+
+// Repository identifies the repository an event belongs to
+type Repository struct {
+	FullName  string `json:"full_name"`
+	IsPrivate bool   `json:"is_private"`
+}
+
+// Commit represents a Bitbucket commit summary
+type Commit struct {
+	Message string `json:"message"`
+	Hash    string `json:"hash"`
+}
+
+// NewClient creates a new Bitbucket API client authenticated with an
+// app password.
+func NewClient(username, appPassword string) *Client {
+	return NewClientWithBaseURL(username, appPassword, bitbucketAPIURL)
+}
+
+// NewClientWithBaseURL creates a new Bitbucket API client pointed at a
+// custom base URL (used in tests to point at an httptest.Server).
+func NewClientWithBaseURL(username, appPassword, baseURL string) *Client {
+	return &Client{
+		username:    username,
+		appPassword: appPassword,
+		baseURL:     baseURL,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name identifies this source for event tagging and configuration.
+func (c *Client) Name() string {
+	return "bitbucket"
+}
+
+// FetchDailyEvents implements source.Source.
+func (c *Client) FetchDailyEvents(ctx context.Context) ([]source.FormattedEvent, error) {
+	events, err := c.fetchUserEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+	formatted := make([]source.FormattedEvent, 0, len(events))
+
+	for i := range events {
+		if events[i].CreatedAt.Before(yesterday) {
+			continue
+		}
+		formatted = append(formatted, c.formatEvent(events[i]))
+	}
+
+	return formatted, nil
+}
+
+// fetchUserEvents retrieves the authenticated user's recent repository
+// activity from the Bitbucket API.
+func (c *Client) fetchUserEvents(ctx context.Context) ([]Event, error) {
+	url := fmt.Sprintf("%s/users/%s/events", c.baseURL, c.username)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.SetBasicAuth(c.username, c.appPassword)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var decoded eventsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return decoded.Values, nil
+}
+
+// formatEvent converts a raw Bitbucket event into a normalized FormattedEvent.
+func (c *Client) formatEvent(e Event) source.FormattedEvent {
+	fe := source.FormattedEvent{
+		Source:    c.Name(),
+		Type:      e.Type,
+		Repo:      e.Repository.FullName,
+		CreateAt:  e.CreatedAt,
+		IsPrivate: e.Repository.IsPrivate,
+	}
+
+	if e.Commit != nil {
+		fe.Commits = 1
+		fe.CommitMessages = []string{e.Commit.Message}
+	}
+
+	return fe
+}
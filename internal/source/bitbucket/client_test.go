@@ -0,0 +1,87 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchDailyEvents_FormatsCommitEvent(t *testing.T) {
+	recent := time.Now().Add(-1 * time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "testuser" || password != "test-pass" {
+			t.Errorf("Expected basic auth testuser/test-pass, got: %s/%s (ok=%v)", username, password, ok)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"values": [
+				{
+					"type": "repo:push",
+					"created_on": "` + recent + `",
+					"repository": {"full_name": "team/repo", "is_private": true},
+					"commit": {"message": "Fix bug", "hash": "abc123"}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "test-pass", server.URL)
+	events, err := client.FetchDailyEvents(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got: %d", len(events))
+	}
+	if events[0].Repo != "team/repo" {
+		t.Errorf("Expected repo team/repo, got: %s", events[0].Repo)
+	}
+	if !events[0].IsPrivate {
+		t.Error("Expected IsPrivate=true")
+	}
+	if events[0].Commits != 1 {
+		t.Errorf("Expected 1 commit, got: %d", events[0].Commits)
+	}
+}
+
+func TestFetchDailyEvents_FiltersEventsOlderThanYesterday(t *testing.T) {
+	stale := time.Now().Add(-48 * time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"values": [
+				{"type": "repo:push", "created_on": "` + stale + `", "repository": {"full_name": "team/repo"}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "test-pass", server.URL)
+	events, err := client.FetchDailyEvents(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected stale events to be filtered out, got: %d", len(events))
+	}
+}
+
+func TestFetchDailyEvents_NonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "bad-pass", server.URL)
+	if _, err := client.FetchDailyEvents(context.Background()); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
@@ -0,0 +1,140 @@
+// Package gitlab implements source.Source against the GitLab events API.
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+const gitlabAPIURL = "https://gitlab.com/api/v4"
+
+// Client handles GitLab API interactions
+type Client struct {
+	username   string
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Event represents a GitLab user event
+type Event struct {
+	ActionName  string    `json:"action_name"`
+	TargetType  string    `json:"target_type"`
+	TargetTitle string    `json:"target_title"`
+	CreatedAt   time.Time `json:"created_at"`
+	ProjectID   int       `json:"project_id"`
+	PushData    *PushData `json:"push_data"`
+}
+
+// PushData represents push-specific fields on a push event
+type PushData struct {
+	CommitCount int    `json:"commit_count"`
+	Ref         string `json:"ref"`
+	CommitTitle string `json:"commit_title"`
+}
+
+// NewClient creates a new GitLab API client using a personal access token.
+func NewClient(username, token string) *Client {
+	return NewClientWithBaseURL(username, token, gitlabAPIURL)
+}
+
+// NewClientWithBaseURL creates a new GitLab API client pointed at a custom
+// base URL (used in tests to point at an httptest.Server).
+func NewClientWithBaseURL(username, token, baseURL string) *Client {
+	return &Client{
+		username: username,
+		token:    token,
+		baseURL:  baseURL,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name identifies this source for event tagging and configuration.
+func (c *Client) Name() string {
+	return "gitlab"
+}
+
+// FetchDailyEvents implements source.Source.
+func (c *Client) FetchDailyEvents(ctx context.Context) ([]source.FormattedEvent, error) {
+	events, err := c.fetchUserEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+	formatted := make([]source.FormattedEvent, 0, len(events))
+
+	for i := range events {
+		if events[i].CreatedAt.Before(yesterday) {
+			continue
+		}
+		formatted = append(formatted, c.formatEvent(events[i]))
+	}
+
+	return formatted, nil
+}
+
+// fetchUserEvents retrieves the authenticated user's events from the
+// GitLab events API, filtering server-side with the after parameter.
+func (c *Client) fetchUserEvents(ctx context.Context) ([]Event, error) {
+	after := time.Now().Add(-24 * time.Hour).Format("2006-01-02")
+	url := fmt.Sprintf("%s/events?after=%s", c.baseURL, after)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var events []Event
+	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return events, nil
+}
+
+// formatEvent converts a raw GitLab event into a normalized FormattedEvent.
+func (c *Client) formatEvent(e Event) source.FormattedEvent {
+	fe := source.FormattedEvent{
+		Source:   c.Name(),
+		Type:     e.ActionName,
+		Repo:     fmt.Sprintf("project/%d", e.ProjectID),
+		CreateAt: e.CreatedAt,
+		Action:   e.ActionName,
+	}
+
+	if e.PushData != nil {
+		fe.Commits = e.PushData.CommitCount
+		fe.Branch = e.PushData.Ref
+		if e.PushData.CommitTitle != "" {
+			fe.CommitMessages = []string{e.PushData.CommitTitle}
+		}
+	}
+
+	if e.TargetTitle != "" {
+		fe.PRTitle = e.TargetTitle
+	}
+
+	return fe
+}
@@ -0,0 +1,84 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchDailyEvents_FormatsPushEvent(t *testing.T) {
+	now := time.Now().UTC()
+	recent := now.Add(-1 * time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "test-token" {
+			t.Errorf("Expected PRIVATE-TOKEN header, got: %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{
+				"action_name": "pushed to",
+				"target_type": null,
+				"created_at": "` + recent + `",
+				"project_id": 42,
+				"push_data": {"commit_count": 3, "ref": "main", "commit_title": "Fix bug"}
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "test-token", server.URL)
+	events, err := client.FetchDailyEvents(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got: %d", len(events))
+	}
+	if events[0].Repo != "project/42" {
+		t.Errorf("Expected repo project/42, got: %s", events[0].Repo)
+	}
+	if events[0].Commits != 3 {
+		t.Errorf("Expected 3 commits, got: %d", events[0].Commits)
+	}
+	if events[0].Branch != "main" {
+		t.Errorf("Expected branch main, got: %s", events[0].Branch)
+	}
+}
+
+func TestFetchDailyEvents_FiltersEventsOlderThanYesterday(t *testing.T) {
+	stale := time.Now().Add(-48 * time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"action_name": "pushed to", "created_at": "` + stale + `", "project_id": 1}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "test-token", server.URL)
+	events, err := client.FetchDailyEvents(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected stale events to be filtered out, got: %d", len(events))
+	}
+}
+
+func TestFetchDailyEvents_NonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL("testuser", "bad-token", server.URL)
+	if _, err := client.FetchDailyEvents(context.Background()); err == nil {
+		t.Fatal("Expected an error for a non-200 response")
+	}
+}
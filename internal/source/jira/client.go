@@ -0,0 +1,162 @@
+// Package jira implements source.Source against the Jira Cloud REST API,
+// reporting issues the current user touched in the last day.
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// dailyJQL restricts the search to issues assigned to the current user
+// that were updated within the last day.
+const dailyJQL = "updated >= -1d AND assignee = currentUser()"
+
+// Client handles Jira API interactions
+type Client struct {
+	baseURL    string
+	email      string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// searchResponse is the envelope returned by the Jira search endpoint.
+type searchResponse struct {
+	Issues []Issue `json:"issues"`
+}
+
+// Issue represents a single Jira issue matched by the JQL query.
+type Issue struct {
+	Key    string `json:"key"`
+	Fields Fields `json:"fields"`
+}
+
+// Fields holds the subset of issue fields the reporter cares about.
+type Fields struct {
+	Summary string      `json:"summary"`
+	Updated jiraTime    `json:"updated"`
+	Project Project     `json:"project"`
+	Status  IssueStatus `json:"status"`
+}
+
+// jiraTimeLayout matches the timestamp format Jira Cloud returns for date
+// fields (e.g. "2023-10-05T14:30:00.000+0000"), which uses a zone offset
+// with no colon and so isn't valid RFC3339.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// jiraTime decodes Jira Cloud's non-RFC3339 timestamp format, since the
+// default time.Time unmarshaling rejects it.
+type jiraTime time.Time
+
+// UnmarshalJSON parses a Jira Cloud timestamp string using jiraTimeLayout.
+func (t *jiraTime) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal jira timestamp: %w", err)
+	}
+
+	parsed, err := time.Parse(jiraTimeLayout, raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse jira timestamp %q: %w", raw, err)
+	}
+
+	*t = jiraTime(parsed)
+	return nil
+}
+
+// Project identifies the project an issue belongs to.
+type Project struct {
+	Key string `json:"key"`
+}
+
+// IssueStatus carries the current workflow status of an issue.
+type IssueStatus struct {
+	Name string `json:"name"`
+}
+
+// NewClient creates a new Jira API client for a Jira Cloud instance at
+// baseURL (e.g. "https://your-domain.atlassian.net"), authenticated with
+// basic auth using the account email and an API token.
+func NewClient(baseURL, email, apiToken string) *Client {
+	return &Client{
+		baseURL:  baseURL,
+		email:    email,
+		apiToken: apiToken,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name identifies this source for event tagging and configuration.
+func (c *Client) Name() string {
+	return "jira"
+}
+
+// FetchDailyEvents implements source.Source.
+func (c *Client) FetchDailyEvents(ctx context.Context) ([]source.FormattedEvent, error) {
+	issues, err := c.searchIssues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	formatted := make([]source.FormattedEvent, 0, len(issues))
+	for _, issue := range issues {
+		formatted = append(formatted, c.formatIssue(issue))
+	}
+
+	return formatted, nil
+}
+
+// searchIssues runs the daily JQL query against the Jira search endpoint.
+func (c *Client) searchIssues(ctx context.Context) ([]Issue, error) {
+	endpoint := fmt.Sprintf(
+		"%s/rest/api/3/search?jql=%s",
+		c.baseURL,
+		url.QueryEscape(dailyJQL),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.SetBasicAuth(c.email, c.apiToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var decoded searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return decoded.Issues, nil
+}
+
+// formatIssue converts a raw Jira issue into a normalized FormattedEvent.
+func (c *Client) formatIssue(issue Issue) source.FormattedEvent {
+	return source.FormattedEvent{
+		Source:   c.Name(),
+		Type:     "IssueTransition",
+		Repo:     issue.Fields.Project.Key,
+		CreateAt: time.Time(issue.Fields.Updated),
+		Action:   issue.Fields.Status.Name,
+		PRTitle:  fmt.Sprintf("%s: %s", issue.Key, issue.Fields.Summary),
+	}
+}
@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchDailyEvents_ParsesJiraTimestamp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"issues": [
+				{
+					"key": "PROJ-123",
+					"fields": {
+						"summary": "Fix the thing",
+						"updated": "2023-10-05T14:30:00.000+0000",
+						"project": {"key": "PROJ"},
+						"status": {"name": "In Progress"}
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test@example.com", "test-token")
+	events, err := client.FetchDailyEvents(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got: %d", len(events))
+	}
+
+	expected := time.Date(2023, 10, 5, 14, 30, 0, 0, time.UTC)
+	if !events[0].CreateAt.Equal(expected) {
+		t.Errorf("Expected CreateAt %v, got: %v", expected, events[0].CreateAt)
+	}
+	if events[0].Repo != "PROJ" {
+		t.Errorf("Expected repo PROJ, got: %s", events[0].Repo)
+	}
+	if !strings.Contains(events[0].PRTitle, "PROJ-123") {
+		t.Errorf("Expected title to contain issue key, got: %s", events[0].PRTitle)
+	}
+}
+
+func TestFetchDailyEvents_InvalidTimestamp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"issues": [
+				{
+					"key": "PROJ-1",
+					"fields": {
+						"summary": "Broken",
+						"updated": "not-a-timestamp",
+						"project": {"key": "PROJ"},
+						"status": {"name": "Open"}
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test@example.com", "test-token")
+	if _, err := client.FetchDailyEvents(context.Background()); err == nil {
+		t.Fatal("Expected an error for a malformed jira timestamp")
+	}
+}
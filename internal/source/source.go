@@ -0,0 +1,87 @@
+// Package source defines the shared contract for anything that can report
+// a user's daily activity (GitHub, GitLab, Bitbucket, Jira, ...).
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FormattedEvent contains processed event information, already normalized
+// across providers so it can be fed directly into a summarizer prompt.
+type FormattedEvent struct {
+	ID             string    `json:"id,omitempty"`
+	Source         string    `json:"source"`
+	Type           string    `json:"type"`
+	Repo           string    `json:"repo"`
+	CreateAt       time.Time `json:"create_at"`
+	IsPrivate      bool      `json:"is_private"`
+	Branch         string    `json:"branch,omitempty"`
+	Commits        int       `json:"commits,omitempty"`
+	CommitMessages []string  `json:"commit_messages,omitempty"`
+	RefType        string    `json:"ref_type,omitempty"`
+	Ref            string    `json:"ref,omitempty"`
+	Action         string    `json:"action,omitempty"`
+	PRTitle        string    `json:"pr_title,omitempty"`
+}
+
+// Source is anything that can report a user's activity for the last 24 hours.
+type Source interface {
+	// Name identifies the source (e.g. "github", "gitlab"), used to tag
+	// the events it produces and to select it from configuration.
+	Name() string
+
+	// FetchDailyEvents returns the formatted events for the last 24 hours,
+	// or, for a source backed by a store, since its last successful run.
+	FetchDailyEvents(ctx context.Context) ([]FormattedEvent, error)
+}
+
+// Merge runs every source in parallel, tags each event with its source,
+// and returns the combined results ordered chronologically. Errors from
+// individual sources are collected and returned alongside whatever events
+// the other sources managed to fetch.
+func Merge(ctx context.Context, sources []Source) ([]FormattedEvent, error) {
+	type result struct {
+		name   string
+		events []FormattedEvent
+		err    error
+	}
+
+	results := make(chan result, len(sources))
+
+	for _, src := range sources {
+		go func(src Source) {
+			events, err := src.FetchDailyEvents(ctx)
+			results <- result{name: src.Name(), events: events, err: err}
+		}(src)
+	}
+
+	var all []FormattedEvent
+	var errs []error
+
+	for range sources {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.name, r.err))
+			continue
+		}
+		for i := range r.events {
+			r.events[i].Source = r.name
+		}
+		all = append(all, r.events...)
+	}
+
+	sortByCreatedAt(all)
+
+	return all, errors.Join(errs...)
+}
+
+func sortByCreatedAt(events []FormattedEvent) {
+	for i := 1; i < len(events); i++ {
+		for j := i; j > 0 && events[j].CreateAt.Before(events[j-1].CreateAt); j-- {
+			events[j], events[j-1] = events[j-1], events[j]
+		}
+	}
+}
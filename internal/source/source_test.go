@@ -0,0 +1,69 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type stubSource struct {
+	name   string
+	events []FormattedEvent
+	err    error
+}
+
+func (s *stubSource) Name() string { return s.name }
+
+func (s *stubSource) FetchDailyEvents(_ context.Context) ([]FormattedEvent, error) {
+	return s.events, s.err
+}
+
+func TestMerge_TagsAndOrdersChronologically(t *testing.T) {
+	now := time.Now()
+
+	sources := []Source{
+		&stubSource{
+			name: "github",
+			events: []FormattedEvent{
+				{Type: "PushEvent", CreateAt: now},
+			},
+		},
+		&stubSource{
+			name: "jira",
+			events: []FormattedEvent{
+				{Type: "IssueTransition", CreateAt: now.Add(-1 * time.Hour)},
+			},
+		},
+	}
+
+	events, err := Merge(context.Background(), sources)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got: %d", len(events))
+	}
+
+	if events[0].Source != "jira" {
+		t.Errorf("Expected first event from jira (older), got: %s", events[0].Source)
+	}
+	if events[1].Source != "github" {
+		t.Errorf("Expected second event from github (newer), got: %s", events[1].Source)
+	}
+}
+
+func TestMerge_CollectsPartialFailures(t *testing.T) {
+	sources := []Source{
+		&stubSource{name: "github", events: []FormattedEvent{{Type: "PushEvent"}}},
+		&stubSource{name: "gitlab", err: errors.New("boom")},
+	}
+
+	events, err := Merge(context.Background(), sources)
+	if err == nil {
+		t.Fatal("Expected an error from the failing source")
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected events from the source that succeeded, got: %d", len(events))
+	}
+}
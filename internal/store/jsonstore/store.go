@@ -0,0 +1,188 @@
+// Package jsonstore implements store.Store as a single JSON file, for
+// deployments that don't want a SQLite file (e.g. a read-only filesystem
+// with only a mounted volume for one small file).
+package jsonstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// schemaVersion guards the on-disk document shape: bump it and add an
+// upgrade step in load whenever a field's meaning changes, so existing
+// files on disk upgrade in place instead of being discarded.
+const schemaVersion = 1
+
+// document is the on-disk representation of the store.
+type document struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Events        []source.FormattedEvent `json:"events"`
+	LastRunAt     time.Time               `json:"last_run_at,omitempty"`
+}
+
+// Store is a store.Store backed by a single JSON file.
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// New returns a Store backed by the JSON file at path. The file is created
+// on first write if it doesn't already exist.
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// load reads and upgrades the on-disk document, returning an empty
+// document if the file doesn't exist yet.
+func (s *Store) load() (document, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return document{SchemaVersion: schemaVersion}, nil
+	}
+	if err != nil {
+		return document{}, fmt.Errorf("failed to read store file: %w", err)
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return document{}, fmt.Errorf("failed to decode store file: %w", err)
+	}
+
+	return upgrade(doc), nil
+}
+
+// upgrade brings an older document up to schemaVersion. There is only one
+// version today; future fields land here as additional cases instead of
+// destructive rewrites of the file format.
+func upgrade(doc document) document {
+	if doc.SchemaVersion == 0 {
+		doc.SchemaVersion = schemaVersion
+	}
+
+	return doc
+}
+
+func (s *Store) save(doc document) error {
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode store file: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+
+	return nil
+}
+
+// SaveEvents implements store.Store.
+func (s *Store) SaveEvents(events []source.FormattedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]int, len(doc.Events))
+	for i := range doc.Events {
+		byID[doc.Events[i].ID] = i
+	}
+
+	for _, e := range events {
+		if i, ok := byID[e.ID]; ok && e.ID != "" {
+			doc.Events[i] = e
+			continue
+		}
+		doc.Events = append(doc.Events, e)
+	}
+
+	return s.save(doc)
+}
+
+// SeenEventIDs implements store.Store.
+func (s *Store) SeenEventIDs() (map[string]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(doc.Events))
+	for i := range doc.Events {
+		seen[doc.Events[i].ID] = true
+	}
+
+	return seen, nil
+}
+
+// LastRunAt implements store.Store.
+func (s *Store) LastRunAt() (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, err := s.load()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return doc.LastRunAt, nil
+}
+
+// MarkRun implements store.Store.
+func (s *Store) MarkRun(t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	doc.LastRunAt = t
+
+	return s.save(doc)
+}
+
+// EventsBetween implements store.Store.
+func (s *Store) EventsBetween(from, to time.Time) ([]source.FormattedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []source.FormattedEvent
+	for i := range doc.Events {
+		e := doc.Events[i]
+		if !e.CreateAt.Before(from) && e.CreateAt.Before(to) {
+			events = append(events, e)
+		}
+	}
+
+	sortByCreatedAt(events)
+
+	return events, nil
+}
+
+func sortByCreatedAt(events []source.FormattedEvent) {
+	for i := 1; i < len(events); i++ {
+		for j := i; j > 0 && events[j].CreateAt.Before(events[j-1].CreateAt); j-- {
+			events[j], events[j-1] = events[j-1], events[j]
+		}
+	}
+}
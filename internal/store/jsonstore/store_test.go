@@ -0,0 +1,156 @@
+package jsonstore
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+func TestStore_SaveEvents_PersistsEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	events := []source.FormattedEvent{
+		{ID: "1", Type: "PushEvent", CreateAt: time.Now()},
+		{ID: "2", Type: "PushEvent", CreateAt: time.Now()},
+	}
+
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	doc, err := store.load()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(doc.Events) != 2 {
+		t.Errorf("Expected 2 events saved, got: %d", len(doc.Events))
+	}
+}
+
+func TestStore_SaveEvents_UpsertsByID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	first := []source.FormattedEvent{{ID: "1", Type: "PushEvent", Commits: 1}}
+	if err := store.SaveEvents(first); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	second := []source.FormattedEvent{{ID: "1", Type: "PushEvent", Commits: 5}}
+	if err := store.SaveEvents(second); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	doc, err := store.load()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(doc.Events) != 1 {
+		t.Fatalf("Expected 1 event after upsert, got: %d", len(doc.Events))
+	}
+	if doc.Events[0].Commits != 5 {
+		t.Errorf("Expected upserted event to have Commits=5, got: %d", doc.Events[0].Commits)
+	}
+}
+
+func TestStore_LastRunAt_ZeroWhenNeverRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	lastRun, err := store.LastRunAt()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lastRun.IsZero() {
+		t.Errorf("Expected zero time before any MarkRun, got: %v", lastRun)
+	}
+}
+
+func TestStore_MarkRunThenLastRunAt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	now := time.Now().Truncate(time.Second)
+	if err := store.MarkRun(now); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	lastRun, err := store.LastRunAt()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lastRun.Equal(now) {
+		t.Errorf("Expected LastRunAt=%v, got: %v", now, lastRun)
+	}
+}
+
+func TestStore_SeenEventIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	events := []source.FormattedEvent{
+		{ID: "1", Type: "PushEvent", CreateAt: time.Now()},
+		{ID: "2", Type: "PushEvent", CreateAt: time.Now()},
+	}
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	seen, err := store.SeenEventIDs()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !seen["1"] || !seen["2"] {
+		t.Errorf("Expected both event IDs to be seen, got: %v", seen)
+	}
+	if len(seen) != 2 {
+		t.Errorf("Expected 2 seen IDs, got: %d", len(seen))
+	}
+}
+
+func TestStore_EventsBetween_FiltersAndOrdersByCreateAt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	store := New(path)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []source.FormattedEvent{
+		{ID: "late", CreateAt: base.Add(3 * time.Hour)},
+		{ID: "early", CreateAt: base.Add(1 * time.Hour)},
+		{ID: "outside", CreateAt: base.Add(10 * time.Hour)},
+	}
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	got, err := store.EventsBetween(base, base.Add(5*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 events in range, got: %d", len(got))
+	}
+	if got[0].ID != "early" || got[1].ID != "late" {
+		t.Errorf("Expected events ordered [early, late], got: [%s, %s]", got[0].ID, got[1].ID)
+	}
+}
+
+func TestStore_PersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+
+	first := New(path)
+	if err := first.SaveEvents([]source.FormattedEvent{{ID: "1", CreateAt: time.Now()}}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	second := New(path)
+	doc, err := second.load()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(doc.Events) != 1 || doc.Events[0].ID != "1" {
+		t.Error("Expected event saved by first instance to be visible to second")
+	}
+}
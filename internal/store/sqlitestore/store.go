@@ -0,0 +1,231 @@
+// Package sqlitestore implements store.Store on top of SQLite via
+// modernc.org/sqlite, a pure-Go driver that avoids a CGO dependency.
+package sqlitestore
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // registers the "sqlite" database/sql driver
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// migrations are applied in order, one per schema version, and never
+// rewritten once released: a later version only ever adds tables/columns,
+// so existing rows upgrade in place instead of being discarded. This
+// mirrors the versioned payload migrations used for Gitea webhook bodies.
+var migrations = []string{
+	`CREATE TABLE events (
+		id TEXT PRIMARY KEY,
+		source TEXT NOT NULL,
+		type TEXT NOT NULL,
+		repo TEXT NOT NULL,
+		create_at TIMESTAMP NOT NULL,
+		is_private INTEGER NOT NULL,
+		branch TEXT,
+		commits INTEGER,
+		commit_messages TEXT,
+		ref_type TEXT,
+		ref TEXT,
+		action TEXT,
+		pr_title TEXT
+	)`,
+	`CREATE TABLE runs (
+		id INTEGER PRIMARY KEY CHECK (id = 1),
+		last_run_at TIMESTAMP NOT NULL
+	)`,
+}
+
+// Store is a store.Store backed by a SQLite database file.
+type Store struct {
+	db *sql.DB
+}
+
+// New opens (creating if necessary) the SQLite database at path and
+// applies any migrations that haven't run yet.
+func New(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		db.Close() //nolint:errcheck,gosec // best effort close on migration failure
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// migrate brings the database schema up to the latest version, applying
+// only the migrations newer than schema_migrations' highest recorded
+// version.
+func migrate(db *sql.DB) error {
+	if _, err := db.Exec(
+		`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER NOT NULL)`,
+	); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	var applied int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&applied); err != nil {
+		return fmt.Errorf("failed to read applied migration count: %w", err)
+	}
+
+	for version := applied; version < len(migrations); version++ {
+		if _, err := db.Exec(migrations[version]); err != nil {
+			return fmt.Errorf("failed to apply migration %d: %w", version+1, err)
+		}
+		if _, err := db.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version+1); err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", version+1, err)
+		}
+	}
+
+	return nil
+}
+
+// SaveEvents implements store.Store.
+func (s *Store) SaveEvents(events []source.FormattedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after a successful Commit
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO events (
+			id, source, type, repo, create_at, is_private, branch, commits,
+			commit_messages, ref_type, ref, action, pr_title
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			source = excluded.source,
+			type = excluded.type,
+			repo = excluded.repo,
+			create_at = excluded.create_at,
+			is_private = excluded.is_private,
+			branch = excluded.branch,
+			commits = excluded.commits,
+			commit_messages = excluded.commit_messages,
+			ref_type = excluded.ref_type,
+			ref = excluded.ref,
+			action = excluded.action,
+			pr_title = excluded.pr_title
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close() //nolint:errcheck // best effort close
+
+	for i := range events {
+		e := &events[i]
+		if _, err := stmt.Exec(
+			e.ID, e.Source, e.Type, e.Repo, e.CreateAt, e.IsPrivate, e.Branch, e.Commits,
+			strings.Join(e.CommitMessages, "\n"), e.RefType, e.Ref, e.Action, e.PRTitle,
+		); err != nil {
+			return fmt.Errorf("failed to save event %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SeenEventIDs implements store.Store.
+func (s *Store) SeenEventIDs() (map[string]bool, error) {
+	rows, err := s.db.Query(`SELECT id FROM events`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event IDs: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck // best effort close
+
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan event ID: %w", err)
+		}
+		seen[id] = true
+	}
+
+	return seen, rows.Err()
+}
+
+// LastRunAt implements store.Store.
+func (s *Store) LastRunAt() (time.Time, error) {
+	var lastRunAt time.Time
+
+	err := s.db.QueryRow(`SELECT last_run_at FROM runs WHERE id = 1`).Scan(&lastRunAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read last run time: %w", err)
+	}
+
+	return lastRunAt, nil
+}
+
+// MarkRun implements store.Store.
+func (s *Store) MarkRun(t time.Time) error {
+	_, err := s.db.Exec(`
+		INSERT INTO runs (id, last_run_at) VALUES (1, ?)
+		ON CONFLICT(id) DO UPDATE SET last_run_at = excluded.last_run_at
+	`, t)
+	if err != nil {
+		return fmt.Errorf("failed to mark run: %w", err)
+	}
+
+	return nil
+}
+
+// EventsBetween implements store.Store.
+func (s *Store) EventsBetween(from, to time.Time) ([]source.FormattedEvent, error) {
+	rows, err := s.db.Query(`
+		SELECT id, source, type, repo, create_at, is_private, branch, commits,
+			commit_messages, ref_type, ref, action, pr_title
+		FROM events
+		WHERE create_at >= ? AND create_at < ?
+		ORDER BY create_at ASC
+	`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck // best effort close
+
+	var events []source.FormattedEvent
+	for rows.Next() {
+		var e source.FormattedEvent
+		var commitMessages string
+
+		if err := rows.Scan(
+			&e.ID, &e.Source, &e.Type, &e.Repo, &e.CreateAt, &e.IsPrivate, &e.Branch, &e.Commits,
+			&commitMessages, &e.RefType, &e.Ref, &e.Action, &e.PRTitle,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		if commitMessages != "" {
+			e.CommitMessages = strings.Split(commitMessages, "\n")
+		}
+
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
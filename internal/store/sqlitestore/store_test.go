@@ -0,0 +1,198 @@
+package sqlitestore
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "events.db")
+	store, err := New(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
+
+func TestStore_SaveEvents_PersistsEvents(t *testing.T) {
+	store := openTestStore(t)
+
+	events := []source.FormattedEvent{
+		{ID: "1", Type: "PushEvent", CreateAt: time.Now()},
+		{ID: "2", Type: "PushEvent", CreateAt: time.Now()},
+	}
+
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var count int
+	if err := store.db.QueryRow(`SELECT COUNT(*) FROM events`).Scan(&count); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 events saved, got: %d", count)
+	}
+}
+
+func TestStore_SaveEvents_UpsertsByID(t *testing.T) {
+	store := openTestStore(t)
+
+	if err := store.SaveEvents([]source.FormattedEvent{{ID: "1", Commits: 1, CreateAt: time.Now()}}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := store.SaveEvents([]source.FormattedEvent{{ID: "1", Commits: 5, CreateAt: time.Now()}}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var count, commits int
+	if err := store.db.QueryRow(`SELECT COUNT(*) FROM events`).Scan(&count); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("Expected 1 event after upsert, got: %d", count)
+	}
+	if err := store.db.QueryRow(`SELECT commits FROM events WHERE id = ?`, "1").Scan(&commits); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if commits != 5 {
+		t.Errorf("Expected upserted event to have Commits=5, got: %d", commits)
+	}
+}
+
+func TestStore_LastRunAt_ZeroWhenNeverRun(t *testing.T) {
+	store := openTestStore(t)
+
+	lastRun, err := store.LastRunAt()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lastRun.IsZero() {
+		t.Errorf("Expected zero time before any MarkRun, got: %v", lastRun)
+	}
+}
+
+func TestStore_MarkRunThenLastRunAt(t *testing.T) {
+	store := openTestStore(t)
+
+	now := time.Now().Truncate(time.Second)
+	if err := store.MarkRun(now); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	lastRun, err := store.LastRunAt()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lastRun.Equal(now) {
+		t.Errorf("Expected LastRunAt=%v, got: %v", now, lastRun)
+	}
+}
+
+func TestStore_MarkRun_OverwritesPreviousValue(t *testing.T) {
+	store := openTestStore(t)
+
+	first := time.Now().Add(-time.Hour).Truncate(time.Second)
+	second := time.Now().Truncate(time.Second)
+
+	if err := store.MarkRun(first); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := store.MarkRun(second); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	lastRun, err := store.LastRunAt()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !lastRun.Equal(second) {
+		t.Errorf("Expected LastRunAt=%v, got: %v", second, lastRun)
+	}
+}
+
+func TestStore_SeenEventIDs(t *testing.T) {
+	store := openTestStore(t)
+
+	events := []source.FormattedEvent{
+		{ID: "1", Type: "PushEvent", CreateAt: time.Now()},
+		{ID: "2", Type: "PushEvent", CreateAt: time.Now()},
+	}
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	seen, err := store.SeenEventIDs()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !seen["1"] || !seen["2"] {
+		t.Errorf("Expected both event IDs to be seen, got: %v", seen)
+	}
+	if len(seen) != 2 {
+		t.Errorf("Expected 2 seen IDs, got: %d", len(seen))
+	}
+}
+
+func TestStore_EventsBetween_FiltersAndOrdersByCreateAt(t *testing.T) {
+	store := openTestStore(t)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []source.FormattedEvent{
+		{ID: "late", CreateAt: base.Add(3 * time.Hour)},
+		{ID: "early", CreateAt: base.Add(1 * time.Hour)},
+		{ID: "outside", CreateAt: base.Add(10 * time.Hour)},
+	}
+	if err := store.SaveEvents(events); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	got, err := store.EventsBetween(base, base.Add(5*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 events in range, got: %d", len(got))
+	}
+	if got[0].ID != "early" || got[1].ID != "late" {
+		t.Errorf("Expected events ordered [early, late], got: [%s, %s]", got[0].ID, got[1].ID)
+	}
+}
+
+func TestStore_ReopenAppliesMigrationsOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.db")
+
+	first, err := New(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := first.SaveEvents([]source.FormattedEvent{{ID: "1", CreateAt: time.Now()}}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := first.Close(); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	second, err := New(path)
+	if err != nil {
+		t.Fatalf("Expected no error reopening an already-migrated database, got: %v", err)
+	}
+	defer second.Close() //nolint:errcheck // defer close is best effort
+
+	var count int
+	if err := second.db.QueryRow(`SELECT COUNT(*) FROM events WHERE id = ?`, "1").Scan(&count); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if count != 1 {
+		t.Error("Expected event saved before reopening to still be present")
+	}
+}
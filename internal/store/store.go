@@ -0,0 +1,48 @@
+// Package store defines the shared contract for persisting fetched events
+// across runs, so dedup and report windowing survive process restarts
+// (SQLite, a JSON-file fallback, ...).
+package store
+
+import (
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// Store persists fetched events and tracks run history so a Source can
+// window its fetch off the last successful run instead of a fixed
+// lookback, and so stored history survives a notify failure without
+// duplication.
+type Store interface {
+	// SaveEvents idempotently upserts events, keyed by their ID, so
+	// fetching an overlapping window twice (e.g. after a failed run is
+	// retried) never creates duplicate rows.
+	SaveEvents(events []source.FormattedEvent) error
+
+	// SeenEventIDs returns the IDs of every event SaveEvents has persisted.
+	// It's a query API for callers that want to cross-check history (e.g.
+	// a backfill tool auditing for gaps) — GetDailyEventsContext does NOT
+	// filter its own output through this, since SaveEvents persists an
+	// event as soon as it's fetched, before the caller's notification (and
+	// therefore MarkRun) succeeds. Filtering on it there would make a
+	// crash-mid-run retry silently drop the very event that was never
+	// actually delivered, which is the opposite of what MarkRun's ordering
+	// is for.
+	SeenEventIDs() (map[string]bool, error)
+
+	// LastRunAt returns the time MarkRun was last called with, or the
+	// zero value if MarkRun has never been called.
+	LastRunAt() (time.Time, error)
+
+	// MarkRun records t as the time of the most recent successful run.
+	// Callers should only call this once the rest of the pipeline (e.g.
+	// notification) has also succeeded, so a crash mid-run re-fetches
+	// and re-sends the same window on retry instead of silently skipping
+	// it.
+	MarkRun(t time.Time) error
+
+	// EventsBetween returns the persisted events whose CreateAt falls in
+	// [from, to), ordered oldest first, so a caller can backfill a digest
+	// (e.g. weekly) over a range wider than any single run's window.
+	EventsBetween(from, to time.Time) ([]source.FormattedEvent, error)
+}
@@ -0,0 +1,119 @@
+// Package anthropic implements summarizer.Summarizer against the Anthropic
+// Messages API.
+package anthropic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer"
+)
+
+const (
+	anthropicAPIURL     = "https://api.anthropic.com/v1"
+	anthropicAPIVersion = "2023-06-01"
+	maxSummaryChars     = 4096
+)
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type messagesRequest struct {
+	Model     string    `json:"model"`
+	MaxTokens int32     `json:"max_tokens"`
+	Messages  []message `json:"messages"`
+}
+
+type messagesResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// Client handles Anthropic Messages API interactions.
+type Client struct {
+	apiKey     string
+	baseURL    string
+	cfg        summarizer.Config
+	httpClient *http.Client
+}
+
+// NewClient creates a new Anthropic summarizer client.
+func NewClient(apiKey string, cfg summarizer.Config) *Client {
+	return NewClientWithBaseURL(apiKey, cfg, anthropicAPIURL)
+}
+
+// NewClientWithBaseURL creates a new Anthropic summarizer client pointed at
+// a custom base URL (used in tests to point at an httptest.Server).
+func NewClientWithBaseURL(apiKey string, cfg summarizer.Config, baseURL string) *Client {
+	return &Client{
+		apiKey:  apiKey,
+		baseURL: baseURL,
+		cfg:     cfg,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Summarize implements summarizer.Summarizer.
+func (c *Client) Summarize(ctx context.Context, events []source.FormattedEvent) (string, error) {
+	if len(events) == 0 {
+		return "Hoje foi um dia de planejamento e reflexão no código.", nil
+	}
+
+	prompt, err := summarizer.BuildPrompt(summarizer.DefaultPromptTemplate, events)
+	if err != nil {
+		return "", fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	reqBody := messagesRequest{
+		Model:     c.cfg.Model,
+		MaxTokens: c.cfg.MaxOutputTokens,
+		Messages:  []message{{Role: "user", Content: prompt}},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/messages", c.baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("anthropic-version", anthropicAPIVersion)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var decoded messagesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(decoded.Content) == 0 {
+		return "", fmt.Errorf("empty response from Anthropic")
+	}
+
+	return summarizer.TruncateSummary(decoded.Content[0].Text, maxSummaryChars), nil
+}
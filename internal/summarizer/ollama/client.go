@@ -0,0 +1,106 @@
+// Package ollama implements summarizer.Summarizer against a local Ollama
+// server, letting users summarize fully offline.
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer"
+)
+
+const (
+	defaultBaseURL  = "http://localhost:11434"
+	maxSummaryChars = 4096
+)
+
+type generateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type generateResponse struct {
+	Response string `json:"response"`
+}
+
+// Client handles Ollama API interactions.
+type Client struct {
+	baseURL    string
+	cfg        summarizer.Config
+	httpClient *http.Client
+}
+
+// NewClient creates a new Ollama summarizer client pointed at baseURL. If
+// baseURL is empty it defaults to the standard local Ollama endpoint.
+func NewClient(baseURL string, cfg summarizer.Config) *Client {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	return &Client{
+		baseURL: baseURL,
+		cfg:     cfg,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+	}
+}
+
+// Summarize implements summarizer.Summarizer.
+func (c *Client) Summarize(ctx context.Context, events []source.FormattedEvent) (string, error) {
+	if len(events) == 0 {
+		return "Hoje foi um dia de planejamento e reflexão no código.", nil
+	}
+
+	prompt, err := summarizer.BuildPrompt(summarizer.DefaultPromptTemplate, events)
+	if err != nil {
+		return "", fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	reqBody := generateRequest{
+		Model:  c.cfg.Model,
+		Prompt: prompt,
+		Stream: false,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/generate", c.baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var decoded generateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if decoded.Response == "" {
+		return "", fmt.Errorf("empty response from Ollama")
+	}
+
+	return summarizer.TruncateSummary(decoded.Response, maxSummaryChars), nil
+}
@@ -0,0 +1,120 @@
+// Package openai implements summarizer.Summarizer against the OpenAI
+// chat completions API.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+	"github.com/carvalhocaio/routines-in-the-night/internal/summarizer"
+)
+
+const openAIAPIURL = "https://api.openai.com/v1"
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+	MaxTokens   int32         `json:"max_tokens"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// Client handles OpenAI chat completions API interactions.
+type Client struct {
+	apiKey     string
+	baseURL    string
+	cfg        summarizer.Config
+	httpClient *http.Client
+}
+
+// NewClient creates a new OpenAI summarizer client.
+func NewClient(apiKey string, cfg summarizer.Config) *Client {
+	return NewClientWithBaseURL(apiKey, cfg, openAIAPIURL)
+}
+
+// NewClientWithBaseURL creates a new OpenAI summarizer client pointed at a
+// custom base URL (used in tests to point at an httptest.Server).
+func NewClientWithBaseURL(apiKey string, cfg summarizer.Config, baseURL string) *Client {
+	return &Client{
+		apiKey:  apiKey,
+		baseURL: baseURL,
+		cfg:     cfg,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Summarize implements summarizer.Summarizer.
+func (c *Client) Summarize(ctx context.Context, events []source.FormattedEvent) (string, error) {
+	if len(events) == 0 {
+		return "Hoje foi um dia de planejamento e reflexão no código.", nil
+	}
+
+	prompt, err := summarizer.BuildPrompt(summarizer.DefaultPromptTemplate, events)
+	if err != nil {
+		return "", fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	reqBody := chatRequest{
+		Model:       c.cfg.Model,
+		Messages:    []chatMessage{{Role: "user", Content: prompt}},
+		Temperature: c.cfg.Temperature,
+		MaxTokens:   c.cfg.MaxOutputTokens,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/chat/completions", c.baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // defer close is best effort
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var decoded chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(decoded.Choices) == 0 {
+		return "", fmt.Errorf("empty response from OpenAI")
+	}
+
+	return summarizer.TruncateSummary(decoded.Choices[0].Message.Content, maxSummaryChars), nil
+}
+
+// maxSummaryChars mirrors the notifier layer's Discord embed limit so the
+// default output stays renderable without truncation downstream.
+const maxSummaryChars = 4096
@@ -0,0 +1,55 @@
+// Package summarizer defines the shared contract for anything that can turn
+// a day's events into a narrative summary (Gemini, OpenAI, Anthropic,
+// Ollama, ...).
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+// Config carries the model parameters common to every backend.
+type Config struct {
+	Model           string
+	Temperature     float64
+	MaxOutputTokens int32
+}
+
+// Summarizer turns a list of events into a human-readable daily summary.
+type Summarizer interface {
+	Summarize(ctx context.Context, events []source.FormattedEvent) (string, error)
+}
+
+// BuildPrompt renders template with the events marshaled as indented JSON,
+// the same shape every backend's prompt is built from.
+func BuildPrompt(template string, events []source.FormattedEvent) (string, error) {
+	eventsJSON, err := json.MarshalIndent(events, "", " ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal events: %w", err)
+	}
+
+	return fmt.Sprintf(template, eventsJSON), nil
+}
+
+// TruncateSummary ensures the summary fits within maxChars while
+// preserving complete sentences.
+func TruncateSummary(summary string, maxChars int) string {
+	if len(summary) <= maxChars {
+		return summary
+	}
+
+	// Find the latest period before the limit
+	truncated := summary[:maxChars]
+	lastPeriod := strings.LastIndex(truncated, ".")
+
+	if lastPeriod > 0 {
+		return summary[:lastPeriod+1]
+	}
+
+	// No period found, truncate at limit
+	return truncated
+}
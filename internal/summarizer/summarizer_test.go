@@ -0,0 +1,60 @@
+package summarizer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carvalhocaio/routines-in-the-night/internal/source"
+)
+
+func TestBuildPrompt(t *testing.T) {
+	events := []source.FormattedEvent{
+		{Source: "github", Type: "PushEvent", Repo: "user/repo", CreateAt: time.Now()},
+	}
+
+	prompt, err := BuildPrompt("Events: %s", events)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(prompt, "user/repo") {
+		t.Errorf("Expected prompt to contain repo name, got: %s", prompt)
+	}
+}
+
+func TestTruncateSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		summary  string
+		maxChars int
+		expected string
+	}{
+		{
+			name:     "under limit",
+			summary:  "Short summary.",
+			maxChars: 100,
+			expected: "Short summary.",
+		},
+		{
+			name:     "over limit with period",
+			summary:  "First sentence. Second sentence. Third sentence.",
+			maxChars: 35,
+			expected: "First sentence. Second sentence.",
+		},
+		{
+			name:     "over limit no period",
+			summary:  "This is a long message without periods",
+			maxChars: 20,
+			expected: "This is a long messa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TruncateSummary(tt.summary, tt.maxChars)
+			if result != tt.expected {
+				t.Errorf("TruncateSummary(%q, %d) = %q, expected %q", tt.summary, tt.maxChars, result, tt.expected)
+			}
+		})
+	}
+}